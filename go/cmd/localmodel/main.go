@@ -0,0 +1,52 @@
+// Command localmodel runs the LocalModel gRPC backend (see
+// go/localmodel/proto/localmodel.proto) in front of a local Ollama server,
+// plus an OpenAI-compatible HTTP endpoint that localmodel.LocalModelProvider
+// points Stagehand at. Running this lets the whole automation stack work
+// offline except for the Browserbase browser itself.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+
+	"google.golang.org/grpc"
+
+	"github.com/gaurav639/job-application-automation/go/localmodel"
+	"github.com/gaurav639/job-application-automation/go/localmodel/localmodelpb"
+)
+
+func main() {
+	grpcAddr := flag.String("grpc-addr", ":50051", "address for the LocalModel gRPC service")
+	httpAddr := flag.String("http-addr", ":8080", "address for the OpenAI-compatible HTTP endpoint")
+	ollamaURL := flag.String("ollama-url", "http://localhost:11434", "base URL of the local Ollama server")
+	flag.Parse()
+
+	backend := &localmodel.OllamaBackend{BaseURL: *ollamaURL}
+	server := &localmodel.Server{Backend: backend}
+
+	lis, err := net.Listen("tcp", *grpcAddr)
+	if err != nil {
+		fmt.Printf("Failed to listen on %s: %v\n", *grpcAddr, err)
+		os.Exit(1)
+	}
+
+	grpcServer := grpc.NewServer()
+	localmodelpb.RegisterLocalModelServer(grpcServer, server)
+
+	go func() {
+		fmt.Printf("LocalModel gRPC backend listening on %s\n", *grpcAddr)
+		if err := grpcServer.Serve(lis); err != nil {
+			fmt.Printf("gRPC server stopped: %v\n", err)
+			os.Exit(1)
+		}
+	}()
+
+	fmt.Printf("OpenAI-compatible endpoint listening on %s\n", *httpAddr)
+	if err := http.ListenAndServe(*httpAddr, localmodel.OpenAICompatHandler(backend)); err != nil {
+		fmt.Printf("HTTP server stopped: %v\n", err)
+		os.Exit(1)
+	}
+}
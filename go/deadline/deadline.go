@@ -0,0 +1,144 @@
+// Package deadline gives each Stagehand step (Observe, Act, Extract,
+// Execute) its own timeout instead of letting a stuck page hang forever
+// under a single context.Background(), as go/hackernews/main.go does today.
+package deadline
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrStepTimeout is returned by Call when a step's own deadline elapses,
+// distinct from an error returned by the step itself or from the parent
+// context being canceled. Callers can use it to retry just that step
+// instead of restarting the whole session.
+var ErrStepTimeout = errors.New("deadline: step timed out")
+
+// Step identifies which Stagehand call a deadline applies to.
+type Step int
+
+const (
+	StepObserve Step = iota
+	StepAct
+	StepExtract
+	StepExecute
+)
+
+// deadlineTimer arms a timer that closes a channel when it fires, following
+// the same pattern as gonet's deadline timer: SetDeadline stops any
+// previously running timer and recreates the cancel channel, so each call
+// gets its own fresh channel to select against; SetDeadline(0) clears the
+// timer without arming a new one, leaving Done's channel nil (so a select
+// against it blocks forever, i.e. no timeout).
+type deadlineTimer struct {
+	mu     sync.Mutex
+	timer  *time.Timer
+	cancel chan struct{}
+}
+
+// SetDeadline arms the timer to close its cancel channel after d, replacing
+// whatever was previously armed. A zero d clears the timer entirely.
+func (t *deadlineTimer) SetDeadline(d time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.timer != nil {
+		t.timer.Stop()
+	}
+	if d <= 0 {
+		t.timer = nil
+		t.cancel = nil
+		return
+	}
+
+	cancel := make(chan struct{})
+	t.cancel = cancel
+	t.timer = time.AfterFunc(d, func() { close(cancel) })
+}
+
+// Done returns the channel that closes when the currently armed deadline
+// fires, or nil if no deadline is armed.
+func (t *deadlineTimer) Done() <-chan struct{} {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.cancel
+}
+
+// StepDeadlines holds independent timeouts for each step plus a global
+// budget for the whole run.
+type StepDeadlines struct {
+	Global  time.Duration
+	Observe time.Duration
+	Act     time.Duration
+	Extract time.Duration
+	Execute time.Duration
+
+	once   sync.Once
+	timers map[Step]*deadlineTimer
+}
+
+func (s *StepDeadlines) init() {
+	s.once.Do(func() {
+		s.timers = map[Step]*deadlineTimer{
+			StepObserve: {},
+			StepAct:     {},
+			StepExtract: {},
+			StepExecute: {},
+		}
+	})
+}
+
+func (s *StepDeadlines) durationFor(step Step) time.Duration {
+	switch step {
+	case StepObserve:
+		return s.Observe
+	case StepAct:
+		return s.Act
+	case StepExtract:
+		return s.Extract
+	case StepExecute:
+		return s.Execute
+	default:
+		return 0
+	}
+}
+
+// WithBudget derives a context bounded by the global budget, if one is
+// configured. Callers should wrap the context for an entire run (or board)
+// with this once, then pass the result through to Call for each step.
+func (s *StepDeadlines) WithBudget(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.Global <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, s.Global)
+}
+
+// Call derives a child context with the deadline configured for step and
+// runs fn against it. If fn doesn't return before the step's deadline
+// elapses, Call returns ErrStepTimeout rather than waiting on fn; if the
+// parent ctx is canceled first, Call returns ctx.Err() instead. On a step
+// timeout the context passed to fn is canceled, but Call does not wait for
+// fn's goroutine to return; it finishes in the background.
+func (s *StepDeadlines) Call(ctx context.Context, step Step, fn func(ctx context.Context) error) error {
+	s.init()
+	timer := s.timers[step]
+	timer.SetDeadline(s.durationFor(step))
+
+	stepCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() { done <- fn(stepCtx) }()
+
+	select {
+	case err := <-done:
+		return err
+	case <-timer.Done():
+		cancel()
+		return ErrStepTimeout
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
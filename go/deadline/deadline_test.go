@@ -0,0 +1,75 @@
+package deadline
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCallReturnsErrStepTimeoutWhenStepDeadlineElapses(t *testing.T) {
+	s := &StepDeadlines{Act: 10 * time.Millisecond}
+
+	err := s.Call(context.Background(), StepAct, func(ctx context.Context) error {
+		<-ctx.Done() // simulate a stuck call that never returns on its own
+		return ctx.Err()
+	})
+	if !errors.Is(err, ErrStepTimeout) {
+		t.Fatalf("Call error = %v, want ErrStepTimeout", err)
+	}
+}
+
+func TestCallReturnsParentCancelWhenItFiresFirst(t *testing.T) {
+	s := &StepDeadlines{Act: time.Hour} // long enough that the parent wins the race
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := s.Call(ctx, StepAct, func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Call error = %v, want context.Canceled", err)
+	}
+}
+
+func TestCallReturnsFnErrorWhenFnFinishesFirst(t *testing.T) {
+	s := &StepDeadlines{Act: time.Hour}
+	wantErr := errors.New("boom")
+
+	err := s.Call(context.Background(), StepAct, func(ctx context.Context) error {
+		return wantErr
+	})
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("Call error = %v, want %v", err, wantErr)
+	}
+}
+
+func TestCallWithZeroDeadlineNeverTimesOut(t *testing.T) {
+	s := &StepDeadlines{} // every step defaults to 0, i.e. no timeout
+
+	err := s.Call(context.Background(), StepObserve, func(ctx context.Context) error {
+		time.Sleep(20 * time.Millisecond)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Call error = %v, want nil", err)
+	}
+}
+
+func TestSetDeadlineZeroClearsArmedTimer(t *testing.T) {
+	var timer deadlineTimer
+	timer.SetDeadline(5 * time.Millisecond)
+	timer.SetDeadline(0)
+
+	select {
+	case <-timer.Done():
+		t.Fatal("Done channel fired after SetDeadline(0) cleared the timer")
+	case <-time.After(20 * time.Millisecond):
+		// No timeout fired, as expected: Done() should be nil once cleared.
+	}
+	if timer.Done() != nil {
+		t.Fatal("Done() should be nil once the timer is cleared")
+	}
+}
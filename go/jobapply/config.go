@@ -0,0 +1,184 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/gaurav639/job-application-automation/go/deadline"
+	"github.com/gaurav639/job-application-automation/go/jobboard"
+	"github.com/gaurav639/job-application-automation/go/modelprovider"
+	"github.com/gaurav639/job-application-automation/go/store"
+)
+
+// Deadlines mirrors deadline.StepDeadlines with durations expressed as
+// seconds so it round-trips through JSON without a custom marshaler.
+type Deadlines struct {
+	GlobalSeconds  int `json:"global_seconds"`
+	ObserveSeconds int `json:"observe_seconds"`
+	ActSeconds     int `json:"act_seconds"`
+	ExtractSeconds int `json:"extract_seconds"`
+	ExecuteSeconds int `json:"execute_seconds"`
+}
+
+// StepDeadlines converts to deadline.StepDeadlines; zero fields mean "no
+// timeout" for that step, same as deadline.StepDeadlines itself.
+func (d Deadlines) StepDeadlines() *deadline.StepDeadlines {
+	return &deadline.StepDeadlines{
+		Global:  time.Duration(d.GlobalSeconds) * time.Second,
+		Observe: time.Duration(d.ObserveSeconds) * time.Second,
+		Act:     time.Duration(d.ActSeconds) * time.Second,
+		Extract: time.Duration(d.ExtractSeconds) * time.Second,
+		Execute: time.Duration(d.ExecuteSeconds) * time.Second,
+	}
+}
+
+// Config describes one run of the automation: which boards to walk, what to
+// search for on each, and the applicant profile to use when filling out
+// application forms.
+type Config struct {
+	Boards    []string            `json:"boards"`
+	Queries   map[string][]string `json:"queries"` // board name -> search queries (or posting URLs for ATS boards)
+	Applicant jobboard.Applicant  `json:"applicant"`
+	Deadlines Deadlines           `json:"deadlines"`
+
+	// StoreBackend selects the Repository implementation: "sqlite" (default),
+	// "postgres", or "mongo".
+	StoreBackend string `json:"store_backend,omitempty"`
+	// StorePath is the SQLite file tracking applications across runs when
+	// StoreBackend is "sqlite" (the default); defaults to "jobapply.db".
+	StorePath string `json:"store_path,omitempty"`
+	// StoreDSN is the Postgres connection string when StoreBackend is
+	// "postgres", or the MongoDB connection URI when StoreBackend is "mongo".
+	StoreDSN string `json:"store_dsn,omitempty"`
+	// StoreDatabase is the MongoDB database name when StoreBackend is "mongo".
+	StoreDatabase string `json:"store_database,omitempty"`
+
+	// ModelProviders selects which LLM backend(s) handle each Observe/Act/
+	// Extract call, tried in order with automatic fallback. Empty means fall
+	// back to the single hardcoded OpenAI model jobapply always used before
+	// this field existed, configured from MODEL_API_KEY/MODEL_NAME.
+	ModelProviders []modelprovider.BackendConfig `json:"model_providers,omitempty"`
+
+	// Agent switches a board/query from the adapter-driven runBoard path to
+	// the checkpoint-resumable runResumable path (go/resume), for batches
+	// long enough that surviving a dropped session matters more than
+	// board-specific scraping logic. Nil means every board/query uses
+	// runBoard, same as before this field existed.
+	Agent *AgentRun `json:"agent,omitempty"`
+}
+
+// AgentRun configures the resume.ResumableRun driving one board/query.
+type AgentRun struct {
+	// Instruction is the task handed to Sessions.Execute. If empty, it's
+	// generated per board/query as "On <board>, search for "<query>" and
+	// apply to every matching job listing."
+	Instruction string `json:"instruction,omitempty"`
+	// MaxSteps bounds how many Execute steps a single board/query may take
+	// before runResumable gives up and returns. Defaults to 20.
+	MaxSteps int `json:"max_steps,omitempty"`
+	// CheckpointDir is the directory checkpoint files are written to,
+	// one per board/query. Defaults to the current directory.
+	CheckpointDir string `json:"checkpoint_dir,omitempty"`
+}
+
+// defaultAgentMaxSteps is used when AgentRun.MaxSteps is unset, since a zero
+// MaxSteps would make resume.ResumableRun.Run return immediately having done
+// nothing.
+const defaultAgentMaxSteps = 20
+
+// Store opens the Repository named by StoreBackend, defaulting to SQLite
+// when unset so existing jobapply.json files keep working unchanged.
+func (c *Config) Store() (store.Repository, error) {
+	switch c.StoreBackend {
+	case "", "sqlite":
+		path := c.StorePath
+		if path == "" {
+			path = "jobapply.db"
+		}
+		return store.NewSQLiteStore(path)
+	case "postgres":
+		return store.NewPostgresStore(c.StoreDSN)
+	case "mongo":
+		return store.NewMongoStore(c.StoreDSN, c.StoreDatabase)
+	default:
+		return nil, fmt.Errorf("unknown store backend %q", c.StoreBackend)
+	}
+}
+
+// instructionFor returns the Execute instruction for board/query: the
+// configured Instruction if set, or a generated default.
+func (a *AgentRun) instructionFor(board, query string) string {
+	if a.Instruction != "" {
+		return a.Instruction
+	}
+	return fmt.Sprintf("On %s, search for %q and apply to every matching job listing.", board, query)
+}
+
+// maxSteps returns MaxSteps, or defaultAgentMaxSteps if unset.
+func (a *AgentRun) maxSteps() int {
+	if a.MaxSteps > 0 {
+		return a.MaxSteps
+	}
+	return defaultAgentMaxSteps
+}
+
+// defaultModelName is the model jobapply.json's model_providers falls back to
+// when neither it nor MODEL_NAME is set, preserving the hardcoded behavior
+// this package had before model_providers existed.
+const defaultModelName = "openai/gpt-4o-mini"
+
+// Models builds the Fallback provider chain for this run. With no
+// ModelProviders configured, it builds a single OpenAI provider from
+// MODEL_API_KEY/MODEL_NAME so existing jobapply.json files keep working
+// unchanged.
+func (c *Config) Models() (*modelprovider.Fallback, error) {
+	backends := c.ModelProviders
+	if len(backends) == 0 {
+		model := os.Getenv("MODEL_NAME")
+		if model == "" {
+			model = defaultModelName
+		}
+		backends = []modelprovider.BackendConfig{{
+			Type:   "openai",
+			APIKey: os.Getenv("MODEL_API_KEY"),
+			Model:  model,
+		}}
+	}
+	return modelprovider.New(backends)
+}
+
+// LoadConfig reads and validates a JSON config file at path.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read config: %w", err)
+	}
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parse config: %w", err)
+	}
+	if len(cfg.Boards) == 0 {
+		return nil, fmt.Errorf("config must list at least one board")
+	}
+	return &cfg, nil
+}
+
+// adapterFor returns the JobBoardAdapter registered under name.
+func adapterFor(name string) (jobboard.JobBoardAdapter, error) {
+	switch name {
+	case "linkedin":
+		return jobboard.NewLinkedIn(), nil
+	case "indeed":
+		return jobboard.NewIndeed(), nil
+	case "greenhouse":
+		return jobboard.NewGreenhouse(), nil
+	case "lever":
+		return jobboard.NewLever(), nil
+	case "workday":
+		return jobboard.NewWorkday(), nil
+	default:
+		return nil, fmt.Errorf("unknown job board adapter %q", name)
+	}
+}
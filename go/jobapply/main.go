@@ -0,0 +1,244 @@
+// Command jobapply walks a set of job boards with a Stagehand browser
+// session, searching each for the configured queries and applying to every
+// listing it finds. See go/hackernews for a smaller, single-board walkthrough
+// of the underlying Sessions API.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/browserbase/stagehand-go"
+	"github.com/browserbase/stagehand-go/option"
+
+	"github.com/gaurav639/job-application-automation/go/deadline"
+	"github.com/gaurav639/job-application-automation/go/jobboard"
+	"github.com/gaurav639/job-application-automation/go/modelprovider"
+	"github.com/gaurav639/job-application-automation/go/resume"
+	"github.com/gaurav639/job-application-automation/go/store"
+)
+
+const sdkVersion = "3.0.7"
+
+func main() {
+	configPath := flag.String("config", "jobapply.json", "path to the run config")
+	flag.Parse()
+
+	cfg, err := LoadConfig(*configPath)
+	if err != nil {
+		fmt.Printf("Failed to load config: %v\n", err)
+		os.Exit(1)
+	}
+
+	repo, err := cfg.Store()
+	if err != nil {
+		fmt.Printf("Failed to open store: %v\n", err)
+		os.Exit(1)
+	}
+	defer repo.Close()
+
+	models, err := cfg.Models()
+	if err != nil {
+		fmt.Printf("Failed to configure model providers: %v\n", err)
+		os.Exit(1)
+	}
+	cost := modelprovider.NewCostTracker()
+
+	client := stagehand.NewClient(
+		option.WithBrowserbaseAPIKey(os.Getenv("BROWSERBASE_API_KEY")),
+		option.WithBrowserbaseProjectID(os.Getenv("BROWSERBASE_PROJECT_ID")),
+		option.WithModelAPIKey(os.Getenv("MODEL_API_KEY")),
+	)
+
+	ctx := context.Background()
+
+	for _, name := range cfg.Boards {
+		adapter, err := adapterFor(name)
+		if err != nil {
+			fmt.Printf("Skipping board %q: %v\n", name, err)
+			continue
+		}
+		for _, query := range cfg.Queries[name] {
+			if cfg.Agent != nil {
+				if err := runResumable(ctx, client, repo, name, query, cfg.Agent, cfg.Deadlines.StepDeadlines(), models, cost); err != nil {
+					fmt.Printf("[%s] %q failed: %v\n", name, query, err)
+				}
+				continue
+			}
+			if err := runBoard(ctx, client, repo, adapter, query, cfg.Applicant, cfg.Deadlines.StepDeadlines(), models, cost); err != nil {
+				fmt.Printf("[%s] %q failed: %v\n", name, query, err)
+			}
+		}
+	}
+
+	if total := cost.Total(); total > 0 {
+		fmt.Printf("total model cost: $%.4f %v\n", total, cost.ByProvider())
+	}
+}
+
+// runBoard starts a fresh session, walks one search query end to end on a
+// single board, and applies to every listing it finds that the store
+// doesn't already have a record for.
+func runBoard(ctx context.Context, client *stagehand.Client, repo store.Repository, adapter jobboard.JobBoardAdapter, query string, applicant jobboard.Applicant, deadlines *deadline.StepDeadlines, models *modelprovider.Fallback, cost *modelprovider.CostTracker) error {
+	ctx, cancelBudget := deadlines.WithBudget(ctx)
+	defer cancelBudget()
+
+	startResponse, err := client.Sessions.Start(ctx, stagehand.SessionStartParams{
+		ModelName:   modelprovider.ModelName(models.ModelConfig(modelprovider.StepObserve)),
+		XLanguage:   stagehand.SessionStartParamsXLanguageTypescript,
+		XSDKVersion: stagehand.String(sdkVersion),
+	})
+	if err != nil {
+		return fmt.Errorf("start session: %w", err)
+	}
+
+	sess := &jobboard.Session{Client: client, ID: startResponse.Data.SessionID, SDKVersion: sdkVersion, Store: repo, Board: adapter.Name(), Deadlines: deadlines, Models: models, Cost: cost}
+	fmt.Printf("[%s] session started: %s\n", adapter.Name(), sess.ID)
+	fmt.Printf("[%s] watch live: https://www.browserbase.com/sessions/%s\n", adapter.Name(), sess.ID)
+	defer func() {
+		_, _ = client.Sessions.End(ctx, sess.ID, stagehand.SessionEndParams{
+			XLanguage:   stagehand.SessionEndParamsXLanguageTypescript,
+			XSDKVersion: stagehand.String(sdkVersion),
+		})
+	}()
+
+	if err := adapter.Login(ctx, sess); err != nil {
+		return fmt.Errorf("login: %w", err)
+	}
+	if err := adapter.Search(ctx, sess, query); err != nil {
+		return fmt.Errorf("search: %w", err)
+	}
+
+	for {
+		listing, ok, err := adapter.NextListing(ctx, sess)
+		if errors.Is(err, deadline.ErrStepTimeout) {
+			// NextListing's Observe call alone timed out; retry it once
+			// rather than tearing down the whole session.
+			listing, ok, err = adapter.NextListing(ctx, sess)
+		}
+		if err != nil {
+			return fmt.Errorf("next listing: %w", err)
+		}
+		if !ok {
+			return nil
+		}
+
+		jobID := listing.URL
+		if jobID == "" {
+			jobID = listing.Title
+		}
+		if jobID == "" {
+			// Greenhouse/Lever listings carry neither; their query is the
+			// posting URL itself.
+			jobID = query
+		}
+		sess.JobID = jobID
+
+		if _, err := repo.Get(ctx, adapter.Name(), jobID); err == nil {
+			fmt.Printf("[%s] skipping %q: already tracked\n", adapter.Name(), jobID)
+			continue
+		} else if err != store.ErrNotFound {
+			return fmt.Errorf("check store: %w", err)
+		}
+
+		posting, err := adapter.ExtractJob(ctx, sess, listing)
+		if err != nil {
+			fmt.Printf("[%s] extract failed: %v\n", adapter.Name(), err)
+			continue
+		}
+		fmt.Printf("[%s] found %q at %s\n", adapter.Name(), posting.Title, posting.Company)
+
+		result, err := adapter.Apply(ctx, sess, posting, applicant)
+		if err != nil {
+			fmt.Printf("[%s] apply failed for %q: %v\n", adapter.Name(), posting.Title, err)
+			continue
+		}
+		fmt.Printf("[%s] applied to %q: %s\n", adapter.Name(), posting.Title, result.Message)
+
+		record := &store.Record{
+			Board:       adapter.Name(),
+			JobID:       jobID,
+			Status:      store.StatusApplied,
+			AppliedAt:   time.Now(),
+			SessionID:   sess.ID,
+			ExtractedJD: posting.Description,
+		}
+		if err := repo.Put(ctx, record); err != nil {
+			fmt.Printf("[%s] failed to persist record for %q: %v\n", adapter.Name(), posting.Title, err)
+		}
+	}
+}
+
+// runResumable drives one board/query through resume.ResumableRun instead of
+// a JobBoardAdapter, checkpointing to disk after every Execute step so the
+// run survives a dropped session or an API outage and picks back up on the
+// next invocation instead of restarting the whole query from scratch. A
+// query the store already has a completed record for is skipped, the same
+// way runBoard skips a listing it has already applied to.
+func runResumable(ctx context.Context, client *stagehand.Client, repo store.Repository, board, query string, agent *AgentRun, deadlines *deadline.StepDeadlines, models *modelprovider.Fallback, cost *modelprovider.CostTracker) error {
+	if _, err := repo.Get(ctx, board, query); err == nil {
+		fmt.Printf("[%s] %q skipped: already tracked\n", board, query)
+		return nil
+	} else if err != store.ErrNotFound {
+		return fmt.Errorf("check store: %w", err)
+	}
+
+	ctx, cancelBudget := deadlines.WithBudget(ctx)
+	defer cancelBudget()
+
+	label := sanitizeLabel(board + "-" + query)
+	run := &resume.ResumableRun{
+		Client:         client,
+		Label:          label,
+		CheckpointPath: filepath.Join(checkpointDir(agent), label+".checkpoint.json"),
+		Instruction:    agent.instructionFor(board, query),
+		MaxSteps:       agent.maxSteps(),
+		ModelName:      modelprovider.ModelName(models.ModelConfig(modelprovider.StepExecute)),
+		Deadlines:      deadlines,
+		Store:          repo,
+		Board:          board,
+		JobID:          query,
+		Cost:           cost,
+	}
+
+	cp, err := run.Run(ctx)
+	if err != nil {
+		return fmt.Errorf("agent run: %w", err)
+	}
+	fmt.Printf("[%s] %q agent run finished after %d step(s), last seen at %s\n", board, query, cp.StepIndex, cp.URL)
+
+	record := &store.Record{
+		Board:     board,
+		JobID:     query,
+		Status:    store.StatusApplied,
+		AppliedAt: time.Now(),
+		SessionID: cp.SessionID,
+	}
+	if err := repo.Put(ctx, record); err != nil {
+		fmt.Printf("[%s] %q failed to persist record: %v\n", board, query, err)
+	}
+	return nil
+}
+
+// checkpointDir returns agent.CheckpointDir, or the current directory if unset.
+func checkpointDir(agent *AgentRun) string {
+	if agent.CheckpointDir != "" {
+		return agent.CheckpointDir
+	}
+	return "."
+}
+
+// sanitizeLabel turns a board+query pair into a filesystem-safe checkpoint
+// file name by collapsing whitespace runs and path separators (and ':') into
+// "-".
+func sanitizeLabel(s string) string {
+	s = strings.Join(strings.Fields(s), "-")
+	replacer := strings.NewReplacer("/", "-", "\\", "-", ":", "-")
+	return replacer.Replace(s)
+}
@@ -0,0 +1,112 @@
+// Package jobboard defines the pluggable interface used to drive a single
+// job board through a Stagehand browser session, plus the concrete adapters
+// for the boards this tool knows how to apply to.
+package jobboard
+
+import (
+	"context"
+
+	"github.com/browserbase/stagehand-go"
+
+	"github.com/gaurav639/job-application-automation/go/deadline"
+	"github.com/gaurav639/job-application-automation/go/modelprovider"
+	"github.com/gaurav639/job-application-automation/go/store"
+)
+
+// Session bundles the pieces every adapter needs to talk to Stagehand so
+// individual adapters don't have to thread a client and session ID through
+// every method call.
+//
+// Store, Board and JobID are optional: when Store is set, every Act call
+// made through this Session is recorded as a store.AuditRow against
+// Board/JobID so a user can re-watch any historical application. Callers
+// update Board/JobID as they move from one listing to the next. The
+// resumable, agent-driven path doesn't go through Session at all; see
+// resume.ResumableRun's own Store/Board/JobID fields for its Execute audit
+// trail instead.
+type Session struct {
+	Client     *stagehand.Client
+	ID         string
+	SDKVersion string
+
+	Store store.Repository
+	Board string
+	JobID string
+
+	// Deadlines bounds how long each Observe/Act/Extract/Execute call is
+	// allowed to run, if set. A nil Deadlines means calls use ctx as given.
+	Deadlines *deadline.StepDeadlines
+
+	// Models selects which backend (and fallback chain) handles each
+	// Observe/Act/Extract call, if set. A nil Models leaves the model choice
+	// to whatever Sessions.Start configured for the session.
+	Models *modelprovider.Fallback
+	// Cost accumulates Usage for every call made through Models, if set.
+	Cost *modelprovider.CostTracker
+}
+
+// JobListing is a single row surfaced while paging through a board's search
+// results, before the full posting has been extracted.
+type JobListing struct {
+	Title   string
+	Company string
+	URL     string
+}
+
+// JobPosting is the structured detail pulled from a single job page via
+// Extract, ready to be matched against the applicant's profile or persisted.
+type JobPosting struct {
+	Title       string
+	Company     string
+	Location    string
+	Salary      string
+	Description string
+	URL         string
+}
+
+// Applicant holds the fields common application forms ask for. Boards that
+// need more (e.g. screening questions) extend this via their own Act
+// sequences; ApplyResult reports only whether Apply submitted and the final
+// Act message, not which fields were filled in.
+type Applicant struct {
+	Name            string
+	Email           string
+	Phone           string
+	ResumePath      string
+	CoverLetterPath string
+}
+
+// ApplyResult reports what happened when an adapter ran its Apply sequence.
+type ApplyResult struct {
+	Submitted bool
+	Message   string
+}
+
+// JobBoardAdapter encapsulates everything that's specific to one job board:
+// how to log in, how to search, how to page through results, how to extract
+// a posting's detail, and how to fill out and submit its application form.
+// Adapters keep only their position in the current search results between
+// calls (reset by Search); everything else lives in the Session they're
+// given.
+type JobBoardAdapter interface {
+	// Name identifies the adapter for logging and for store.Record.Board.
+	Name() string
+
+	// Login authenticates the session, if the board requires it before
+	// search results or application forms become visible.
+	Login(ctx context.Context, sess *Session) error
+
+	// Search navigates to the board's results page for the given query.
+	Search(ctx context.Context, sess *Session, query string) error
+
+	// NextListing advances to the next result on the current search page,
+	// returning ok=false once the page (or board) has no more listings.
+	NextListing(ctx context.Context, sess *Session) (listing *JobListing, ok bool, err error)
+
+	// ExtractJob opens a listing and extracts its full posting detail.
+	ExtractJob(ctx context.Context, sess *Session, listing *JobListing) (*JobPosting, error)
+
+	// Apply drives the board's application form to completion for the given
+	// posting using the supplied applicant profile.
+	Apply(ctx context.Context, sess *Session, posting *JobPosting, applicant Applicant) (*ApplyResult, error)
+}
@@ -0,0 +1,217 @@
+package jobboard
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/browserbase/stagehand-go"
+
+	"github.com/gaurav639/job-application-automation/go/deadline"
+	"github.com/gaurav639/job-application-automation/go/modelprovider"
+	"github.com/gaurav639/job-application-automation/go/store"
+)
+
+// withDeadline runs fn under sess's configured deadline for step, if any;
+// otherwise it runs fn against ctx directly.
+func withDeadline(ctx context.Context, sess *Session, step deadline.Step, fn func(ctx context.Context) error) error {
+	if sess.Deadlines == nil {
+		return fn(ctx)
+	}
+	return sess.Deadlines.Call(ctx, step, fn)
+}
+
+// withModel runs fn once per candidate in sess.Models, in fallback order,
+// passing the model config the call should use this attempt; fn also gets
+// sess's configured deadline applied around it. With no Models configured,
+// fn runs once against ctx with a nil model (the session's Start-time model
+// applies). Every successful attempt is recorded against sess.Cost, if set.
+func withModel(ctx context.Context, sess *Session, step modelprovider.Step, dstep deadline.Step, fn func(ctx context.Context, model *stagehand.ModelConfigUnionParam) error) error {
+	attempt := func(ctx context.Context, model *stagehand.ModelConfigUnionParam) error {
+		return withDeadline(ctx, sess, dstep, func(ctx context.Context) error {
+			return fn(ctx, model)
+		})
+	}
+
+	if sess.Models == nil {
+		return attempt(ctx, nil)
+	}
+
+	return sess.Models.Try(ctx, step, func(ctx context.Context, provider modelprovider.Provider) error {
+		cfg := provider.ModelConfig(step)
+		err := attempt(ctx, &cfg)
+		if err == nil && sess.Cost != nil {
+			// The SDK doesn't surface token usage on Observe/Act/Extract
+			// responses, so cost tracking here is call accounting per
+			// provider/step rather than a priced total; Execute callers that
+			// do get usage back can record real CostUSD.
+			sess.Cost.Record(modelprovider.Usage{Provider: provider.Name(), Step: step})
+		}
+		return err
+	})
+}
+
+// escapeQuery percent-encodes a search query for use in a board's search URL.
+func escapeQuery(query string) string {
+	return url.QueryEscape(query)
+}
+
+// The helpers below wrap the raw Stagehand session calls so individual
+// adapters don't repeat the XLanguage/XSDKVersion boilerplate from
+// go/hackernews/main.go on every Observe/Act/Extract/Navigate.
+
+func navigate(ctx context.Context, sess *Session, url string) error {
+	_, err := sess.Client.Sessions.Navigate(ctx, sess.ID, stagehand.SessionNavigateParams{
+		URL:         url,
+		FrameID:     stagehand.String(""),
+		XLanguage:   stagehand.SessionNavigateParamsXLanguageTypescript,
+		XSDKVersion: stagehand.String(sess.SDKVersion),
+	})
+	return err
+}
+
+func observe(ctx context.Context, sess *Session, instruction string) ([]stagehand.Action, error) {
+	var actions []stagehand.Action
+	err := withModel(ctx, sess, modelprovider.StepObserve, deadline.StepObserve, func(ctx context.Context, model *stagehand.ModelConfigUnionParam) error {
+		resp, err := sess.Client.Sessions.Observe(ctx, sess.ID, stagehand.SessionObserveParams{
+			Instruction: stagehand.String(instruction),
+			Model:       model,
+			XLanguage:   stagehand.SessionObserveParamsXLanguageTypescript,
+			XSDKVersion: stagehand.String(sess.SDKVersion),
+		})
+		if err != nil {
+			return err
+		}
+		actions = resp.Data.Result
+		return nil
+	})
+	return actions, err
+}
+
+func act(ctx context.Context, sess *Session, action stagehand.Action) (string, error) {
+	var message string
+	err := withModel(ctx, sess, modelprovider.StepAct, deadline.StepAct, func(ctx context.Context, model *stagehand.ModelConfigUnionParam) error {
+		resp, err := sess.Client.Sessions.Act(ctx, sess.ID, stagehand.SessionActParams{
+			Input: stagehand.SessionActParamsInputUnion{
+				OfAction: &stagehand.ActionParam{
+					Description: action.Description,
+					Selector:    action.Selector,
+					Method:      stagehand.String(action.Method),
+					Arguments:   action.Arguments,
+				},
+			},
+			Model:       model,
+			XLanguage:   stagehand.SessionActParamsXLanguageTypescript,
+			XSDKVersion: stagehand.String(sess.SDKVersion),
+		})
+		if err != nil {
+			return err
+		}
+		message = resp.Data.Result.Message
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	auditAct(ctx, sess, action.Description)
+	return message, nil
+}
+
+// actOn is a convenience for acting directly on free-form text instead of an
+// Observe result, e.g. "type 'Jane Doe' into the name field".
+func actOn(ctx context.Context, sess *Session, instruction string) (string, error) {
+	var message string
+	err := withModel(ctx, sess, modelprovider.StepAct, deadline.StepAct, func(ctx context.Context, model *stagehand.ModelConfigUnionParam) error {
+		resp, err := sess.Client.Sessions.Act(ctx, sess.ID, stagehand.SessionActParams{
+			Input: stagehand.SessionActParamsInputUnion{
+				OfString: stagehand.String(instruction),
+			},
+			Model:       model,
+			XLanguage:   stagehand.SessionActParamsXLanguageTypescript,
+			XSDKVersion: stagehand.String(sess.SDKVersion),
+		})
+		if err != nil {
+			return err
+		}
+		message = resp.Data.Result.Message
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	auditAct(ctx, sess, instruction)
+	return message, nil
+}
+
+// auditAct records an Act call against sess.Store, if the caller configured
+// one. An audit failure's error is discarded and never fails the underlying
+// Stagehand call, so a broken store can't block an application.
+func auditAct(ctx context.Context, sess *Session, detail string) {
+	if sess.Store == nil {
+		return
+	}
+	_ = sess.Store.AppendAudit(ctx, store.AuditRow{
+		Board:      sess.Board,
+		JobID:      sess.JobID,
+		SessionID:  sess.ID,
+		Step:       "act",
+		Detail:     detail,
+		OccurredAt: time.Now(),
+	})
+}
+
+func extract(ctx context.Context, sess *Session, instruction string, schema map[string]any) (map[string]any, error) {
+	var result map[string]any
+	err := withModel(ctx, sess, modelprovider.StepExtract, deadline.StepExtract, func(ctx context.Context, model *stagehand.ModelConfigUnionParam) error {
+		resp, err := sess.Client.Sessions.Extract(ctx, sess.ID, stagehand.SessionExtractParams{
+			Instruction: stagehand.String(instruction),
+			Schema:      schema,
+			Model:       model,
+			XLanguage:   stagehand.SessionExtractParamsXLanguageTypescript,
+			XSDKVersion: stagehand.String(sess.SDKVersion),
+		})
+		if err != nil {
+			return err
+		}
+		result, _ = resp.Data.Result.(map[string]any)
+		return nil
+	})
+	return result, err
+}
+
+// jobPostingSchema is the Extract schema shared by every adapter: a job
+// posting's title, company, location, salary and description are the same
+// shape regardless of which board they came from.
+func jobPostingSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"title": map[string]any{
+				"type":        "string",
+				"description": "The job title",
+			},
+			"company": map[string]any{
+				"type":        "string",
+				"description": "The hiring company's name",
+			},
+			"location": map[string]any{
+				"type":        "string",
+				"description": "The job location, or \"Remote\"",
+			},
+			"salary": map[string]any{
+				"type":        "string",
+				"description": "The posted salary or compensation range, if any",
+			},
+			"description": map[string]any{
+				"type":        "string",
+				"description": "The full job description text",
+			},
+		},
+		"required": []string{"title", "company"},
+	}
+}
+
+func stringField(fields map[string]any, key string) string {
+	v, _ := fields[key].(string)
+	return v
+}
@@ -0,0 +1,76 @@
+package jobboard
+
+import (
+	"context"
+	"fmt"
+)
+
+// Greenhouse drives an individual company's boards.greenhouse.io posting
+// page. Unlike LinkedIn/Indeed, Greenhouse has no search of its own: callers
+// reach a listing directly, so Search just navigates to the single known
+// posting URL supplied as the query and NextListing yields that one posting.
+type Greenhouse struct {
+	visited bool
+}
+
+// NewGreenhouse returns a JobBoardAdapter for Greenhouse-hosted postings.
+func NewGreenhouse() *Greenhouse { return &Greenhouse{} }
+
+func (a *Greenhouse) Name() string { return "greenhouse" }
+
+func (a *Greenhouse) Login(ctx context.Context, sess *Session) error {
+	// Greenhouse application forms don't require an account.
+	return nil
+}
+
+func (a *Greenhouse) Search(ctx context.Context, sess *Session, query string) error {
+	a.visited = false
+	// query is the posting URL itself: Greenhouse has no cross-company search.
+	return navigate(ctx, sess, query)
+}
+
+func (a *Greenhouse) NextListing(ctx context.Context, sess *Session) (*JobListing, bool, error) {
+	if a.visited {
+		return nil, false, nil
+	}
+	a.visited = true
+	return &JobListing{}, true, nil
+}
+
+func (a *Greenhouse) ExtractJob(ctx context.Context, sess *Session, listing *JobListing) (*JobPosting, error) {
+	fields, err := extract(ctx, sess, "extract the job posting details from this Greenhouse job page", jobPostingSchema())
+	if err != nil {
+		return nil, err
+	}
+	return &JobPosting{
+		Title:       stringField(fields, "title"),
+		Company:     stringField(fields, "company"),
+		Location:    stringField(fields, "location"),
+		Salary:      stringField(fields, "salary"),
+		Description: stringField(fields, "description"),
+	}, nil
+}
+
+func (a *Greenhouse) Apply(ctx context.Context, sess *Session, posting *JobPosting, applicant Applicant) (*ApplyResult, error) {
+	if _, err := actOn(ctx, sess, "scroll to and open the application form at the bottom of the page"); err != nil {
+		return nil, err
+	}
+	if _, err := actOn(ctx, sess, fmt.Sprintf("fill the \"First Name\", \"Last Name\" and \"Email\" fields using the name %q and email %q", applicant.Name, applicant.Email)); err != nil {
+		return nil, err
+	}
+	if applicant.ResumePath != "" {
+		if _, err := actOn(ctx, sess, fmt.Sprintf("upload the resume file at %q to the resume field", applicant.ResumePath)); err != nil {
+			return nil, err
+		}
+	}
+	if applicant.CoverLetterPath != "" {
+		if _, err := actOn(ctx, sess, fmt.Sprintf("upload the cover letter file at %q if a cover letter field is present", applicant.CoverLetterPath)); err != nil {
+			return nil, err
+		}
+	}
+	message, err := actOn(ctx, sess, "click \"Submit Application\"")
+	if err != nil {
+		return nil, err
+	}
+	return &ApplyResult{Submitted: true, Message: message}, nil
+}
@@ -0,0 +1,78 @@
+package jobboard
+
+import (
+	"context"
+	"fmt"
+)
+
+// Indeed drives indeed.com search and application flow. Indeed surfaces
+// either its own "Indeed Apply" form or redirects out to the employer's ATS;
+// Apply handles both by falling back to a generic instruction.
+type Indeed struct {
+	index int
+}
+
+// NewIndeed returns a JobBoardAdapter for Indeed.
+func NewIndeed() *Indeed { return &Indeed{} }
+
+func (a *Indeed) Name() string { return "indeed" }
+
+func (a *Indeed) Login(ctx context.Context, sess *Session) error {
+	// Indeed allows searching and viewing postings without an account, so
+	// Login does nothing; Apply has no sign-in step of its own either.
+	return nil
+}
+
+func (a *Indeed) Search(ctx context.Context, sess *Session, query string) error {
+	a.index = 0
+	url := fmt.Sprintf("https://www.indeed.com/jobs?q=%s", escapeQuery(query))
+	return navigate(ctx, sess, url)
+}
+
+func (a *Indeed) NextListing(ctx context.Context, sess *Session) (*JobListing, bool, error) {
+	actions, err := observe(ctx, sess, fmt.Sprintf("find job card number %d in the search results", a.index+1))
+	if err != nil {
+		return nil, false, err
+	}
+	if len(actions) == 0 {
+		return nil, false, nil
+	}
+	a.index++
+	return &JobListing{Title: actions[0].Description}, true, nil
+}
+
+func (a *Indeed) ExtractJob(ctx context.Context, sess *Session, listing *JobListing) (*JobPosting, error) {
+	if _, err := actOn(ctx, sess, fmt.Sprintf("click the job card: %s", listing.Title)); err != nil {
+		return nil, err
+	}
+	fields, err := extract(ctx, sess, "extract the job posting details shown in the detail pane", jobPostingSchema())
+	if err != nil {
+		return nil, err
+	}
+	return &JobPosting{
+		Title:       stringField(fields, "title"),
+		Company:     stringField(fields, "company"),
+		Location:    stringField(fields, "location"),
+		Salary:      stringField(fields, "salary"),
+		Description: stringField(fields, "description"),
+	}, nil
+}
+
+func (a *Indeed) Apply(ctx context.Context, sess *Session, posting *JobPosting, applicant Applicant) (*ApplyResult, error) {
+	if _, err := actOn(ctx, sess, "click \"Apply now\""); err != nil {
+		return nil, err
+	}
+	if _, err := actOn(ctx, sess, fmt.Sprintf("fill the name field with %q and the email field with %q", applicant.Name, applicant.Email)); err != nil {
+		return nil, err
+	}
+	if applicant.ResumePath != "" {
+		if _, err := actOn(ctx, sess, fmt.Sprintf("attach the resume at %q", applicant.ResumePath)); err != nil {
+			return nil, err
+		}
+	}
+	message, err := actOn(ctx, sess, "continue through the remaining Indeed Apply steps and submit")
+	if err != nil {
+		return nil, err
+	}
+	return &ApplyResult{Submitted: true, Message: message}, nil
+}
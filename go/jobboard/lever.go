@@ -0,0 +1,69 @@
+package jobboard
+
+import (
+	"context"
+	"fmt"
+)
+
+// Lever drives an individual company's jobs.lever.co posting page. Like
+// Greenhouse, Lever has no cross-company search: Search navigates straight
+// to the posting URL the caller provides.
+type Lever struct {
+	visited bool
+}
+
+// NewLever returns a JobBoardAdapter for Lever-hosted postings.
+func NewLever() *Lever { return &Lever{} }
+
+func (a *Lever) Name() string { return "lever" }
+
+func (a *Lever) Login(ctx context.Context, sess *Session) error {
+	// Lever application forms don't require an account.
+	return nil
+}
+
+func (a *Lever) Search(ctx context.Context, sess *Session, query string) error {
+	a.visited = false
+	return navigate(ctx, sess, query)
+}
+
+func (a *Lever) NextListing(ctx context.Context, sess *Session) (*JobListing, bool, error) {
+	if a.visited {
+		return nil, false, nil
+	}
+	a.visited = true
+	return &JobListing{}, true, nil
+}
+
+func (a *Lever) ExtractJob(ctx context.Context, sess *Session, listing *JobListing) (*JobPosting, error) {
+	fields, err := extract(ctx, sess, "extract the job posting details from this Lever job page", jobPostingSchema())
+	if err != nil {
+		return nil, err
+	}
+	return &JobPosting{
+		Title:       stringField(fields, "title"),
+		Company:     stringField(fields, "company"),
+		Location:    stringField(fields, "location"),
+		Salary:      stringField(fields, "salary"),
+		Description: stringField(fields, "description"),
+	}, nil
+}
+
+func (a *Lever) Apply(ctx context.Context, sess *Session, posting *JobPosting, applicant Applicant) (*ApplyResult, error) {
+	if _, err := actOn(ctx, sess, "click the \"Apply for this job\" button"); err != nil {
+		return nil, err
+	}
+	if _, err := actOn(ctx, sess, fmt.Sprintf("fill the \"Full name\" field with %q and the \"Email\" field with %q", applicant.Name, applicant.Email)); err != nil {
+		return nil, err
+	}
+	if applicant.ResumePath != "" {
+		if _, err := actOn(ctx, sess, fmt.Sprintf("upload the resume file at %q", applicant.ResumePath)); err != nil {
+			return nil, err
+		}
+	}
+	message, err := actOn(ctx, sess, "submit the application")
+	if err != nil {
+		return nil, err
+	}
+	return &ApplyResult{Submitted: true, Message: message}, nil
+}
@@ -0,0 +1,79 @@
+package jobboard
+
+import (
+	"context"
+	"fmt"
+)
+
+// LinkedIn drives linkedin.com/jobs. Login is optional: an authenticated
+// session gets "Easy Apply", but search and extraction work logged out too.
+type LinkedIn struct {
+	index int
+}
+
+// NewLinkedIn returns a JobBoardAdapter for LinkedIn.
+func NewLinkedIn() *LinkedIn { return &LinkedIn{} }
+
+func (a *LinkedIn) Name() string { return "linkedin" }
+
+func (a *LinkedIn) Login(ctx context.Context, sess *Session) error {
+	if err := navigate(ctx, sess, "https://www.linkedin.com/login"); err != nil {
+		return err
+	}
+	_, err := actOn(ctx, sess, "log in with the saved session credentials if a login form is present")
+	return err
+}
+
+func (a *LinkedIn) Search(ctx context.Context, sess *Session, query string) error {
+	a.index = 0
+	url := fmt.Sprintf("https://www.linkedin.com/jobs/search/?keywords=%s", escapeQuery(query))
+	return navigate(ctx, sess, url)
+}
+
+func (a *LinkedIn) NextListing(ctx context.Context, sess *Session) (*JobListing, bool, error) {
+	actions, err := observe(ctx, sess, fmt.Sprintf("find job listing card number %d in the results list", a.index+1))
+	if err != nil {
+		return nil, false, err
+	}
+	if len(actions) == 0 {
+		return nil, false, nil
+	}
+	a.index++
+	return &JobListing{Title: actions[0].Description}, true, nil
+}
+
+func (a *LinkedIn) ExtractJob(ctx context.Context, sess *Session, listing *JobListing) (*JobPosting, error) {
+	if _, err := actOn(ctx, sess, fmt.Sprintf("click the job listing card: %s", listing.Title)); err != nil {
+		return nil, err
+	}
+	fields, err := extract(ctx, sess, "extract the open job posting's details from the detail pane", jobPostingSchema())
+	if err != nil {
+		return nil, err
+	}
+	return &JobPosting{
+		Title:       stringField(fields, "title"),
+		Company:     stringField(fields, "company"),
+		Location:    stringField(fields, "location"),
+		Salary:      stringField(fields, "salary"),
+		Description: stringField(fields, "description"),
+	}, nil
+}
+
+func (a *LinkedIn) Apply(ctx context.Context, sess *Session, posting *JobPosting, applicant Applicant) (*ApplyResult, error) {
+	if _, err := actOn(ctx, sess, "click the \"Easy Apply\" button"); err != nil {
+		return nil, err
+	}
+	if _, err := actOn(ctx, sess, fmt.Sprintf("fill the contact email field with %q", applicant.Email)); err != nil {
+		return nil, err
+	}
+	if applicant.ResumePath != "" {
+		if _, err := actOn(ctx, sess, fmt.Sprintf("upload the resume file at %q", applicant.ResumePath)); err != nil {
+			return nil, err
+		}
+	}
+	message, err := actOn(ctx, sess, "submit the application, advancing through any additional screening steps")
+	if err != nil {
+		return nil, err
+	}
+	return &ApplyResult{Submitted: true, Message: message}, nil
+}
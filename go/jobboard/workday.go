@@ -0,0 +1,85 @@
+package jobboard
+
+import (
+	"context"
+	"fmt"
+)
+
+// Workday drives a company's myworkdayjobs.com career site. Workday's
+// application flow is a multi-page wizard (account, profile, experience,
+// voluntary disclosures, review); Apply advances it one step at a time
+// rather than assuming a single form submission finishes it.
+type Workday struct {
+	index int
+}
+
+// NewWorkday returns a JobBoardAdapter for Workday-hosted postings.
+func NewWorkday() *Workday { return &Workday{} }
+
+func (a *Workday) Name() string { return "workday" }
+
+func (a *Workday) Login(ctx context.Context, sess *Session) error {
+	_, err := actOn(ctx, sess, "sign in with the saved candidate account if a sign-in prompt is shown, otherwise continue as a guest")
+	return err
+}
+
+func (a *Workday) Search(ctx context.Context, sess *Session, query string) error {
+	a.index = 0
+	url := fmt.Sprintf("https://company.wd1.myworkdayjobs.com/en-US/careers?q=%s", escapeQuery(query))
+	return navigate(ctx, sess, url)
+}
+
+func (a *Workday) NextListing(ctx context.Context, sess *Session) (*JobListing, bool, error) {
+	actions, err := observe(ctx, sess, fmt.Sprintf("find job posting link number %d in the results list", a.index+1))
+	if err != nil {
+		return nil, false, err
+	}
+	if len(actions) == 0 {
+		return nil, false, nil
+	}
+	a.index++
+	return &JobListing{Title: actions[0].Description}, true, nil
+}
+
+func (a *Workday) ExtractJob(ctx context.Context, sess *Session, listing *JobListing) (*JobPosting, error) {
+	if _, err := actOn(ctx, sess, fmt.Sprintf("click the job posting link: %s", listing.Title)); err != nil {
+		return nil, err
+	}
+	fields, err := extract(ctx, sess, "extract the job posting details from this Workday posting page", jobPostingSchema())
+	if err != nil {
+		return nil, err
+	}
+	return &JobPosting{
+		Title:       stringField(fields, "title"),
+		Company:     stringField(fields, "company"),
+		Location:    stringField(fields, "location"),
+		Salary:      stringField(fields, "salary"),
+		Description: stringField(fields, "description"),
+	}, nil
+}
+
+// workdaySteps are the wizard pages Apply advances through, in order.
+var workdaySteps = []string{
+	"click \"Apply\" and choose \"Apply Manually\" if prompted",
+	"fill the account email and password fields, creating an account if needed, then continue",
+	"fill the \"My Information\" page's name, phone and address fields and continue",
+	"upload the resume on the \"My Experience\" page and continue",
+	"answer any voluntary disclosure questions with \"Decline to answer\" where offered and continue",
+	"review the application summary and submit",
+}
+
+func (a *Workday) Apply(ctx context.Context, sess *Session, posting *JobPosting, applicant Applicant) (*ApplyResult, error) {
+	var message string
+	for _, step := range workdaySteps {
+		instruction := step
+		if applicant.Name != "" || applicant.Email != "" {
+			instruction = fmt.Sprintf("%s (name: %q, email: %q, phone: %q, resume: %q)", step, applicant.Name, applicant.Email, applicant.Phone, applicant.ResumePath)
+		}
+		out, err := actOn(ctx, sess, instruction)
+		if err != nil {
+			return nil, err
+		}
+		message = out
+	}
+	return &ApplyResult{Submitted: true, Message: message}, nil
+}
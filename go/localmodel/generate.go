@@ -0,0 +1,8 @@
+package localmodel
+
+// ./localmodelpb is generated from proto/localmodel.proto and checked in, so
+// this package and go/cmd/localmodel build without anyone needing protoc
+// installed. Re-run this generate line (requires protoc, protoc-gen-go and
+// protoc-gen-go-grpc on PATH) and commit the result whenever the .proto
+// changes; don't hand-edit the generated files.
+//go:generate protoc --go_out=. --go_opt=module=github.com/gaurav639/job-application-automation/go/localmodel --go-grpc_out=. --go-grpc_opt=module=github.com/gaurav639/job-application-automation/go/localmodel proto/localmodel.proto
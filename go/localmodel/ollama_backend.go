@@ -0,0 +1,101 @@
+package localmodel
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type ollamaGenerateRequest struct {
+	Model   string  `json:"model"`
+	Prompt  string  `json:"prompt"`
+	Stream  bool    `json:"stream"`
+	Options struct {
+		NumPredict  int     `json:"num_predict,omitempty"`
+		Temperature float32 `json:"temperature,omitempty"`
+	} `json:"options,omitempty"`
+}
+
+type ollamaGenerateResponse struct {
+	Response        string `json:"response"`
+	PromptEvalCount int    `json:"prompt_eval_count"`
+	EvalCount       int    `json:"eval_count"`
+}
+
+func (b *OllamaBackend) Predict(ctx context.Context, model, prompt string, maxTokens int, temperature float32) (string, int, int, error) {
+	req := ollamaGenerateRequest{Model: model, Prompt: prompt, Stream: false}
+	req.Options.NumPredict = maxTokens
+	req.Options.Temperature = temperature
+
+	var resp ollamaGenerateResponse
+	if err := b.post(ctx, "/api/generate", req, &resp); err != nil {
+		return "", 0, 0, err
+	}
+	return resp.Response, resp.PromptEvalCount, resp.EvalCount, nil
+}
+
+type ollamaEmbedRequest struct {
+	Model string `json:"model"`
+	Input string `json:"input"`
+}
+
+type ollamaEmbedResponse struct {
+	Embeddings [][]float32 `json:"embeddings"`
+}
+
+func (b *OllamaBackend) Embed(ctx context.Context, model, input string) ([]float32, error) {
+	var resp ollamaEmbedResponse
+	if err := b.post(ctx, "/api/embed", ollamaEmbedRequest{Model: model, Input: input}, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Embeddings) == 0 {
+		return nil, fmt.Errorf("ollama returned no embeddings")
+	}
+	return resp.Embeddings[0], nil
+}
+
+type ollamaTokenizeRequest struct {
+	Model string `json:"model"`
+	Text  string `json:"text"`
+}
+
+type ollamaTokenizeResponse struct {
+	Tokens []int32 `json:"tokens"`
+}
+
+func (b *OllamaBackend) Tokenize(ctx context.Context, model, text string) ([]int32, error) {
+	var resp ollamaTokenizeResponse
+	if err := b.post(ctx, "/api/tokenize", ollamaTokenizeRequest{Model: model, Text: text}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Tokens, nil
+}
+
+func (b *OllamaBackend) post(ctx context.Context, path string, body, out any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.baseURL()+path, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := b.httpClient().Do(req)
+	if err != nil {
+		return fmt.Errorf("call ollama %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("ollama %s returned status %d", path, resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode ollama %s response: %w", path, err)
+	}
+	return nil
+}
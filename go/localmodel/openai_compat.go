@@ -0,0 +1,91 @@
+package localmodel
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// OpenAICompatHandler serves just enough of the OpenAI chat completions API
+// for Stagehand's Observe/Extract calls to work against a Backend, so
+// LocalModelProvider can point an ordinary BaseURL/APIKey model config at
+// cmd/localmodel instead of a hosted API.
+func OpenAICompatHandler(backend Backend) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/chat/completions", chatCompletionsHandler(backend))
+	return mux
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatCompletionsRequest struct {
+	Model       string        `json:"model"`
+	Messages    []chatMessage `json:"messages"`
+	MaxTokens   int           `json:"max_tokens"`
+	Temperature float32       `json:"temperature"`
+}
+
+type chatCompletionsChoice struct {
+	Index   int         `json:"index"`
+	Message chatMessage `json:"message"`
+}
+
+type chatCompletionsResponse struct {
+	Model   string                  `json:"model"`
+	Choices []chatCompletionsChoice `json:"choices"`
+	Usage   chatCompletionsUsage    `json:"usage"`
+}
+
+type chatCompletionsUsage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+}
+
+func chatCompletionsHandler(backend Backend) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		var req chatCompletionsRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		prompt := flattenMessages(req.Messages)
+		text, promptTokens, completionTokens, err := backend.Predict(r.Context(), req.Model, prompt, req.MaxTokens, req.Temperature)
+		if err != nil {
+			http.Error(w, "predict failed: "+err.Error(), http.StatusBadGateway)
+			return
+		}
+
+		resp := chatCompletionsResponse{
+			Model: req.Model,
+			Choices: []chatCompletionsChoice{
+				{Index: 0, Message: chatMessage{Role: "assistant", Content: text}},
+			},
+			Usage: chatCompletionsUsage{PromptTokens: promptTokens, CompletionTokens: completionTokens},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// flattenMessages collapses a chat history into the single prompt string
+// Backend.Predict expects; Ollama's /api/generate has no notion of roles.
+func flattenMessages(messages []chatMessage) string {
+	var b strings.Builder
+	for _, m := range messages {
+		b.WriteString(m.Role)
+		b.WriteString(": ")
+		b.WriteString(m.Content)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,35 @@
+package localmodel
+
+import (
+	"github.com/browserbase/stagehand-go"
+
+	"github.com/gaurav639/job-application-automation/go/modelprovider"
+)
+
+// LocalModelProvider is a modelprovider.Provider that routes Observe and
+// Extract (the structured, cheap-intent steps) to a local LocalModel-backed
+// server and lets Execute escalate to a hosted frontier model via Escalate.
+// It expects cmd/localmodel to be running and exposing an OpenAI-compatible
+// endpoint at BaseURL; that endpoint calls the same Backend as the gRPC
+// Server defined in server.go directly, not through the gRPC service.
+type LocalModelProvider struct {
+	BaseURL string // defaults to "http://localhost:8080/v1"
+	Model   string // local model name, e.g. "llama3.1"
+
+	// Escalate handles StepExecute; required, since Execute needs an agent
+	// capable enough to drive a multi-step browser task.
+	Escalate modelprovider.Provider
+}
+
+func (p *LocalModelProvider) Name() string { return "local" }
+
+func (p *LocalModelProvider) ModelConfig(step modelprovider.Step) stagehand.ModelConfigUnionParam {
+	if step == modelprovider.StepExecute {
+		return p.Escalate.ModelConfig(step)
+	}
+	baseURL := p.BaseURL
+	if baseURL == "" {
+		baseURL = "http://localhost:8080/v1"
+	}
+	return modelprovider.ModelConfigObject(p.Model, "local", baseURL)
+}
@@ -0,0 +1,78 @@
+// Package localmodel implements the LocalModel gRPC backend (see
+// proto/localmodel.proto) in front of a local inference engine, and a
+// modelprovider.Provider that routes Stagehand's cheap, structured steps to
+// it so self-hosters aren't paying a hosted API for every Observe/Extract
+// call.
+package localmodel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gaurav639/job-application-automation/go/localmodel/localmodelpb"
+)
+
+// Backend is the local inference engine a Server proxies to. OllamaBackend
+// below is the only implementation today; a llama.cpp server binding would
+// satisfy the same interface.
+type Backend interface {
+	Predict(ctx context.Context, model, prompt string, maxTokens int, temperature float32) (text string, promptTokens, completionTokens int, err error)
+	Embed(ctx context.Context, model, input string) (embedding []float32, err error)
+	Tokenize(ctx context.Context, model, text string) (tokens []int32, err error)
+}
+
+// Server implements localmodelpb.LocalModelServer over a Backend.
+type Server struct {
+	localmodelpb.UnimplementedLocalModelServer
+	Backend Backend
+}
+
+func (s *Server) Predict(ctx context.Context, req *localmodelpb.PredictRequest) (*localmodelpb.PredictResponse, error) {
+	text, promptTokens, completionTokens, err := s.Backend.Predict(ctx, req.Model, req.Prompt, int(req.MaxTokens), req.Temperature)
+	if err != nil {
+		return nil, fmt.Errorf("predict: %w", err)
+	}
+	return &localmodelpb.PredictResponse{
+		Text:             text,
+		PromptTokens:     int32(promptTokens),
+		CompletionTokens: int32(completionTokens),
+	}, nil
+}
+
+func (s *Server) Embed(ctx context.Context, req *localmodelpb.EmbedRequest) (*localmodelpb.EmbedResponse, error) {
+	embedding, err := s.Backend.Embed(ctx, req.Model, req.Input)
+	if err != nil {
+		return nil, fmt.Errorf("embed: %w", err)
+	}
+	return &localmodelpb.EmbedResponse{Embedding: embedding}, nil
+}
+
+func (s *Server) Tokenize(ctx context.Context, req *localmodelpb.TokenizeRequest) (*localmodelpb.TokenizeResponse, error) {
+	tokens, err := s.Backend.Tokenize(ctx, req.Model, req.Text)
+	if err != nil {
+		return nil, fmt.Errorf("tokenize: %w", err)
+	}
+	return &localmodelpb.TokenizeResponse{Tokens: tokens}, nil
+}
+
+// OllamaBackend implements Backend against a local Ollama server's native
+// API, the default for `ollama pull` + `ollama serve`.
+type OllamaBackend struct {
+	BaseURL string // defaults to "http://localhost:11434" when empty
+	HTTP    *http.Client
+}
+
+func (b *OllamaBackend) baseURL() string {
+	if b.BaseURL != "" {
+		return b.BaseURL
+	}
+	return "http://localhost:11434"
+}
+
+func (b *OllamaBackend) httpClient() *http.Client {
+	if b.HTTP != nil {
+		return b.HTTP
+	}
+	return http.DefaultClient
+}
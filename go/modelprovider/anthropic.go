@@ -0,0 +1,21 @@
+package modelprovider
+
+import "github.com/browserbase/stagehand-go"
+
+// Anthropic wraps the Anthropic API, e.g. for users who already pay for
+// Claude and want Execute to run on it instead of OpenAI.
+type Anthropic struct {
+	APIKey  string
+	Model   string // default model, e.g. "anthropic/claude-sonnet-4-5"
+	PerStep map[Step]string
+}
+
+func (p *Anthropic) Name() string { return "anthropic" }
+
+func (p *Anthropic) ModelConfig(step Step) stagehand.ModelConfigUnionParam {
+	model := p.Model
+	if m, ok := p.PerStep[step]; ok {
+		model = m
+	}
+	return ModelConfigObject(model, p.APIKey, "")
+}
@@ -0,0 +1,72 @@
+package modelprovider
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// BackendConfig is one entry in a config file's "providers" list: a backend
+// to instantiate plus the model (and, for per-step overrides, the step) it
+// should be used for.
+type BackendConfig struct {
+	Type    string          `json:"type"` // "openai", "anthropic", "gemini", "ollama"
+	APIKey  string          `json:"api_key"`
+	BaseURL string          `json:"base_url,omitempty"`
+	Model   string          `json:"model"`
+	PerStep map[Step]string `json:"per_step,omitempty"`
+}
+
+// Config describes the provider chain to use for a run: Providers are tried
+// in order via Fallback, so listing a cheap self-hosted backend first and a
+// hosted frontier model second gets automatic fallback for free.
+type Config struct {
+	Providers []BackendConfig `json:"providers"`
+}
+
+// LoadConfig reads and builds a Fallback provider from a JSON config file.
+func LoadConfig(path string) (*Fallback, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read model provider config: %w", err)
+	}
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parse model provider config: %w", err)
+	}
+	return New(cfg.Providers)
+}
+
+// New builds a Fallback provider from an already-parsed list of backends, for
+// callers (like jobapply.Config) that embed BackendConfig inline rather than
+// pointing at a separate model provider config file.
+func New(backends []BackendConfig) (*Fallback, error) {
+	if len(backends) == 0 {
+		return nil, fmt.Errorf("model provider config must list at least one provider")
+	}
+
+	providers := make([]Provider, 0, len(backends))
+	for _, b := range backends {
+		p, err := build(b)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, p)
+	}
+	return &Fallback{Providers: providers}, nil
+}
+
+func build(b BackendConfig) (Provider, error) {
+	switch b.Type {
+	case "openai":
+		return &OpenAI{APIKey: b.APIKey, Model: b.Model, PerStep: b.PerStep}, nil
+	case "anthropic":
+		return &Anthropic{APIKey: b.APIKey, Model: b.Model, PerStep: b.PerStep}, nil
+	case "gemini":
+		return &Gemini{APIKey: b.APIKey, Model: b.Model, PerStep: b.PerStep}, nil
+	case "ollama":
+		return &Ollama{BaseURL: b.BaseURL, Model: b.Model, PerStep: b.PerStep}, nil
+	default:
+		return nil, fmt.Errorf("unknown model provider type %q", b.Type)
+	}
+}
@@ -0,0 +1,77 @@
+package modelprovider
+
+import "sync"
+
+// Usage is one billing line item: a single Stagehand step's token counts and
+// the cost they worked out to at the provider's published per-token rate.
+type Usage struct {
+	Provider     string
+	Step         Step
+	InputTokens  int
+	OutputTokens int
+	CostUSD      float64
+}
+
+// CostTracker accumulates Usage across a session so a run can report what it
+// spent broken down by provider and step. It's safe for concurrent use since
+// Observe/Act/Extract/Execute calls for different boards may run in
+// parallel.
+type CostTracker struct {
+	mu    sync.Mutex
+	usage []Usage
+	total float64
+}
+
+// NewCostTracker returns an empty CostTracker.
+func NewCostTracker() *CostTracker {
+	return &CostTracker{}
+}
+
+// Record appends a Usage line and adds its cost to the running total.
+func (t *CostTracker) Record(u Usage) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.usage = append(t.usage, u)
+	t.total += u.CostUSD
+}
+
+// Total returns the cumulative cost in USD across every recorded Usage.
+func (t *CostTracker) Total() float64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.total
+}
+
+// ByProvider returns cumulative cost in USD grouped by provider name.
+func (t *CostTracker) ByProvider() map[string]float64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	totals := make(map[string]float64)
+	for _, u := range t.usage {
+		totals[u.Provider] += u.CostUSD
+	}
+	return totals
+}
+
+// pricePerMillionTokens holds each provider's blended list price, in USD per
+// million tokens, for turning a step's token counts into CostUSD. Ollama
+// runs locally and is free; unlisted providers price at zero rather than
+// guessing.
+var pricePerMillionTokens = map[string]struct{ Input, Output float64 }{
+	"anthropic": {Input: 3.00, Output: 15.00},
+	"openai":    {Input: 0.15, Output: 0.60},
+	"gemini":    {Input: 0.10, Output: 0.40},
+	"ollama":    {Input: 0, Output: 0},
+}
+
+// PriceUSD converts a token count for provider into a dollar cost using
+// pricePerMillionTokens. It's the caller's job to populate Usage.CostUSD
+// with this before calling Record; Record itself just sums whatever it's
+// given.
+func PriceUSD(provider string, inputTokens, outputTokens int) float64 {
+	rate, ok := pricePerMillionTokens[provider]
+	if !ok {
+		return 0
+	}
+	return float64(inputTokens)*rate.Input/1_000_000 + float64(outputTokens)*rate.Output/1_000_000
+}
@@ -0,0 +1,53 @@
+package modelprovider
+
+import "testing"
+
+func TestPriceUSDKnownProvider(t *testing.T) {
+	got := PriceUSD("openai", 1_000_000, 1_000_000)
+	want := 0.15 + 0.60
+	if got != want {
+		t.Fatalf("PriceUSD = %v, want %v", got, want)
+	}
+}
+
+func TestPriceUSDUnknownProviderIsZero(t *testing.T) {
+	if got := PriceUSD("made-up", 1_000_000, 1_000_000); got != 0 {
+		t.Fatalf("PriceUSD = %v, want 0", got)
+	}
+}
+
+func TestPriceUSDOllamaIsFree(t *testing.T) {
+	if got := PriceUSD("ollama", 1_000_000, 1_000_000); got != 0 {
+		t.Fatalf("PriceUSD = %v, want 0", got)
+	}
+}
+
+func TestCostTrackerTotalsRecordedUsage(t *testing.T) {
+	tr := NewCostTracker()
+	tr.Record(Usage{Provider: "openai", Step: StepExecute, InputTokens: 1_000_000, CostUSD: PriceUSD("openai", 1_000_000, 0)})
+	tr.Record(Usage{Provider: "anthropic", Step: StepExecute, InputTokens: 1_000_000, CostUSD: PriceUSD("anthropic", 1_000_000, 0)})
+
+	if got, want := tr.Total(), 0.15+3.00; got != want {
+		t.Fatalf("Total = %v, want %v", got, want)
+	}
+	byProvider := tr.ByProvider()
+	if byProvider["openai"] != 0.15 {
+		t.Fatalf("ByProvider[openai] = %v, want 0.15", byProvider["openai"])
+	}
+	if byProvider["anthropic"] != 3.00 {
+		t.Fatalf("ByProvider[anthropic] = %v, want 3.00", byProvider["anthropic"])
+	}
+}
+
+func TestProviderPrefix(t *testing.T) {
+	cases := map[string]string{
+		"anthropic/claude-sonnet-4-5": "anthropic",
+		"openai/gpt-4o-mini":          "openai",
+		"no-slash-model":              "",
+	}
+	for modelName, want := range cases {
+		if got := ProviderPrefix(modelName); got != want {
+			t.Fatalf("ProviderPrefix(%q) = %q, want %q", modelName, got, want)
+		}
+	}
+}
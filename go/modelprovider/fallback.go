@@ -0,0 +1,68 @@
+package modelprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/browserbase/stagehand-go"
+)
+
+// Fallback tries Providers in order, moving to the next one whenever a call
+// fails with a rate-limit or server error. It implements Provider itself so
+// it can be dropped in anywhere a single backend is expected; callers that
+// actually invoke the model (the Sessions.* calls) should use Try instead of
+// ModelConfig so a failed call can be retried against the next provider.
+type Fallback struct {
+	Providers []Provider
+}
+
+func (f *Fallback) Name() string {
+	names := make([]string, len(f.Providers))
+	for i, p := range f.Providers {
+		names[i] = p.Name()
+	}
+	return "fallback(" + strings.Join(names, ",") + ")"
+}
+
+// ModelConfig returns the first provider's config; use Try to get fallback
+// behavior on the actual call.
+func (f *Fallback) ModelConfig(step Step) stagehand.ModelConfigUnionParam {
+	return f.Providers[0].ModelConfig(step)
+}
+
+// Try calls fn once per provider, in order, until one succeeds or every
+// provider has been exhausted. fn is handed the model config to use for this
+// attempt. It only advances to the next provider when the error looks like a
+// rate-limit or server error; any other error is returned immediately.
+func (f *Fallback) Try(ctx context.Context, step Step, fn func(ctx context.Context, provider Provider) error) error {
+	if len(f.Providers) == 0 {
+		return errors.New("modelprovider: fallback has no providers configured")
+	}
+	var lastErr error
+	for _, p := range f.Providers {
+		err := fn(ctx, p)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+		if !isRetryable(err) {
+			return err
+		}
+	}
+	return fmt.Errorf("modelprovider: all providers exhausted: %w", lastErr)
+}
+
+// isRetryable reports whether err looks like a transient rate-limit or
+// server error worth retrying against a different provider, rather than a
+// request-shape error that would fail identically everywhere.
+func isRetryable(err error) bool {
+	msg := strings.ToLower(err.Error())
+	for _, marker := range []string{"429", "rate limit", "rate_limit", "500", "502", "503", "504", "timeout", "overloaded"} {
+		if strings.Contains(msg, marker) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+package modelprovider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/browserbase/stagehand-go"
+)
+
+// stubProvider is a Provider whose ModelConfig is irrelevant to these tests;
+// only Name is used to tell which provider Try picked.
+type stubProvider struct{ name string }
+
+func (p *stubProvider) Name() string { return p.name }
+func (p *stubProvider) ModelConfig(step Step) stagehand.ModelConfigUnionParam {
+	return stagehand.ModelConfigUnionParam{}
+}
+
+func TestFallbackTryAdvancesOnRetryableError(t *testing.T) {
+	f := &Fallback{Providers: []Provider{&stubProvider{"a"}, &stubProvider{"b"}}}
+
+	var tried []string
+	err := f.Try(context.Background(), StepObserve, func(ctx context.Context, p Provider) error {
+		tried = append(tried, p.Name())
+		if p.Name() == "a" {
+			return errors.New("429 rate limit exceeded")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Try returned error: %v", err)
+	}
+	if want := []string{"a", "b"}; !equalStrings(tried, want) {
+		t.Fatalf("tried = %v, want %v", tried, want)
+	}
+}
+
+func TestFallbackTryStopsOnNonRetryableError(t *testing.T) {
+	f := &Fallback{Providers: []Provider{&stubProvider{"a"}, &stubProvider{"b"}}}
+
+	var tried []string
+	wantErr := errors.New("invalid api key")
+	err := f.Try(context.Background(), StepAct, func(ctx context.Context, p Provider) error {
+		tried = append(tried, p.Name())
+		return wantErr
+	})
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("Try error = %v, want %v", err, wantErr)
+	}
+	if want := []string{"a"}; !equalStrings(tried, want) {
+		t.Fatalf("tried = %v, want %v (should not have fallen through to b)", tried, want)
+	}
+}
+
+func TestFallbackTryExhaustsAllProviders(t *testing.T) {
+	f := &Fallback{Providers: []Provider{&stubProvider{"a"}, &stubProvider{"b"}}}
+
+	err := f.Try(context.Background(), StepExtract, func(ctx context.Context, p Provider) error {
+		return errors.New("503 service unavailable")
+	})
+	if err == nil {
+		t.Fatal("Try returned nil error, want all-providers-exhausted error")
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
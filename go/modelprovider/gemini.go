@@ -0,0 +1,20 @@
+package modelprovider
+
+import "github.com/browserbase/stagehand-go"
+
+// Gemini wraps Google's Gemini API.
+type Gemini struct {
+	APIKey  string
+	Model   string // default model, e.g. "google/gemini-2.0-flash"
+	PerStep map[Step]string
+}
+
+func (p *Gemini) Name() string { return "gemini" }
+
+func (p *Gemini) ModelConfig(step Step) stagehand.ModelConfigUnionParam {
+	model := p.Model
+	if m, ok := p.PerStep[step]; ok {
+		model = m
+	}
+	return ModelConfigObject(model, p.APIKey, "")
+}
@@ -0,0 +1,26 @@
+package modelprovider
+
+import "github.com/browserbase/stagehand-go"
+
+// Ollama wraps a locally running Ollama server so self-hosters can avoid
+// paying per token for cheap, structured steps like Observe and Extract.
+// No API key is required; BaseURL defaults to Ollama's local port.
+type Ollama struct {
+	BaseURL string // defaults to "http://localhost:11434/v1" when empty
+	Model   string // default model, e.g. "ollama/llama3.1"
+	PerStep map[Step]string
+}
+
+func (p *Ollama) Name() string { return "ollama" }
+
+func (p *Ollama) ModelConfig(step Step) stagehand.ModelConfigUnionParam {
+	model := p.Model
+	if m, ok := p.PerStep[step]; ok {
+		model = m
+	}
+	baseURL := p.BaseURL
+	if baseURL == "" {
+		baseURL = "http://localhost:11434/v1"
+	}
+	return ModelConfigObject(model, "ollama", baseURL)
+}
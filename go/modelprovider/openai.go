@@ -0,0 +1,21 @@
+package modelprovider
+
+import "github.com/browserbase/stagehand-go"
+
+// OpenAI wraps the OpenAI API. It's the default backend: a single model
+// name covers every step unless PerStep overrides one.
+type OpenAI struct {
+	APIKey  string
+	Model   string          // default model, e.g. "openai/gpt-4o-mini"
+	PerStep map[Step]string // optional per-step overrides
+}
+
+func (p *OpenAI) Name() string { return "openai" }
+
+func (p *OpenAI) ModelConfig(step Step) stagehand.ModelConfigUnionParam {
+	model := p.Model
+	if m, ok := p.PerStep[step]; ok {
+		model = m
+	}
+	return ModelConfigObject(model, p.APIKey, "")
+}
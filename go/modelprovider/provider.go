@@ -0,0 +1,71 @@
+// Package modelprovider lets a run configure which LLM backend handles each
+// Stagehand step (Observe, Act, Extract, Execute) instead of hardcoding a
+// single OpenAI model and API key for everything, as go/hackernews does.
+package modelprovider
+
+import (
+	"strings"
+
+	"github.com/browserbase/stagehand-go"
+)
+
+// Step identifies which Stagehand call a model selection applies to.
+type Step string
+
+const (
+	StepObserve Step = "observe"
+	StepAct     Step = "act"
+	StepExtract Step = "extract"
+	StepExecute Step = "execute"
+)
+
+// Provider resolves a Step to the Stagehand model config that should handle
+// it. Implementations wrap a single backend (Ollama, Anthropic, Gemini,
+// OpenAI); Fallback composes several of them.
+type Provider interface {
+	// Name identifies the provider for logging and cost accounting.
+	Name() string
+
+	// ModelConfig returns the Stagehand model config to use for step.
+	ModelConfig(step Step) stagehand.ModelConfigUnionParam
+}
+
+// ModelConfigObject builds the union param Stagehand expects, shared by
+// every concrete provider below.
+func ModelConfigObject(modelName, apiKey, baseURL string) stagehand.ModelConfigUnionParam {
+	obj := &stagehand.ModelConfigModelConfigObjectParam{
+		ModelName: modelName,
+		APIKey:    stagehand.String(apiKey),
+	}
+	if baseURL != "" {
+		obj.BaseURL = stagehand.String(baseURL)
+	}
+	return stagehand.ModelConfigUnionParam{OfModelConfigModelConfigObject: obj}
+}
+
+// ModelName extracts the plain model name from a config, for callers like
+// Sessions.Start that take a bare ModelName string rather than a full
+// ModelConfigUnionParam.
+func ModelName(cfg stagehand.ModelConfigUnionParam) string {
+	if cfg.OfModelConfigModelConfigObject == nil {
+		return ""
+	}
+	return cfg.OfModelConfigModelConfigObject.ModelName
+}
+
+// ProviderPrefix returns the provider name encoded in a model name like
+// "anthropic/claude-sonnet-4-5" (i.e. everything before the first "/"), or
+// "" if modelName has none. Every concrete Provider's Model field is
+// documented to use this "<provider>/<model>" shape, so this recovers the
+// same name Provider.Name() would return without needing the Provider
+// itself in hand; Gemini's "google/" prefix maps to "gemini".
+func ProviderPrefix(modelName string) string {
+	name, _, found := strings.Cut(modelName, "/")
+	if !found {
+		return ""
+	}
+	if name == "google" {
+		return "gemini"
+	}
+	return name
+}
@@ -0,0 +1,77 @@
+// Package resume drives client.Sessions.Execute one step at a time instead
+// of letting it run its full step budget opaquely, snapshotting progress to
+// disk after each step so an hours-long batch can survive a dead session or
+// an API outage and pick back up where it left off.
+package resume
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Checkpoint is the state needed to resume an agent run in a fresh session:
+// where it was, what it was doing, and what it had extracted so far.
+type Checkpoint struct {
+	SessionID       string         `json:"session_id"`
+	URL             string         `json:"url"`
+	DOMHash         string         `json:"dom_hash"`
+	LastInstruction string         `json:"last_instruction"`
+	StepIndex       int            `json:"step_index"`
+	ExtractedState  map[string]any `json:"extracted_state,omitempty"`
+	Transcript      []string       `json:"transcript,omitempty"` // one entry per completed step's result message
+}
+
+// saveCheckpoint writes cp to path, replacing it atomically so a crash
+// mid-write can never leave a corrupt checkpoint behind.
+func saveCheckpoint(path string, cp Checkpoint) error {
+	data, err := json.MarshalIndent(cp, "", "\t")
+	if err != nil {
+		return fmt.Errorf("marshal checkpoint: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("write checkpoint: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("commit checkpoint: %w", err)
+	}
+	return nil
+}
+
+// loadCheckpoint reads a checkpoint previously written by saveCheckpoint.
+func loadCheckpoint(path string) (Checkpoint, error) {
+	var cp Checkpoint
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cp, fmt.Errorf("read checkpoint: %w", err)
+	}
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return cp, fmt.Errorf("parse checkpoint: %w", err)
+	}
+	return cp, nil
+}
+
+// hasCheckpoint reports whether a checkpoint file exists at path.
+func hasCheckpoint(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// deleteCheckpoint removes a completed run's checkpoint file so the next
+// Run() for the same label starts a fresh run instead of treating a
+// finished job as still in progress. Deleting a nonexistent checkpoint
+// (e.g. one already removed) is not an error.
+func deleteCheckpoint(path string) error {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("remove checkpoint: %w", err)
+	}
+	return nil
+}
+
+// defaultCheckpointPath returns a checkpoint file name derived from a run
+// label, so callers don't have to invent paths for every run.
+func defaultCheckpointPath(label string) string {
+	return filepath.Join(".", fmt.Sprintf("%s.checkpoint.json", label))
+}
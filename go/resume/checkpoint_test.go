@@ -0,0 +1,87 @@
+package resume
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestSaveLoadCheckpointRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.checkpoint.json")
+
+	want := Checkpoint{
+		SessionID:       "sess-1",
+		URL:             "https://boards.example.com/job/1",
+		DOMHash:         "abc123",
+		LastInstruction: "apply to the next listing",
+		StepIndex:       3,
+		ExtractedState:  map[string]any{"title": "Staff Engineer"},
+		Transcript:      []string{"searched for listings", "opened first result"},
+	}
+
+	if hasCheckpoint(path) {
+		t.Fatal("hasCheckpoint reported true before any checkpoint was written")
+	}
+
+	if err := saveCheckpoint(path, want); err != nil {
+		t.Fatalf("saveCheckpoint: %v", err)
+	}
+
+	if !hasCheckpoint(path) {
+		t.Fatal("hasCheckpoint reported false after saveCheckpoint succeeded")
+	}
+
+	got, err := loadCheckpoint(path)
+	if err != nil {
+		t.Fatalf("loadCheckpoint: %v", err)
+	}
+
+	if got.SessionID != want.SessionID || got.URL != want.URL || got.DOMHash != want.DOMHash ||
+		got.LastInstruction != want.LastInstruction || got.StepIndex != want.StepIndex {
+		t.Fatalf("loadCheckpoint = %+v, want %+v", got, want)
+	}
+	if len(got.Transcript) != len(want.Transcript) {
+		t.Fatalf("Transcript = %v, want %v", got.Transcript, want.Transcript)
+	}
+}
+
+func TestSaveCheckpointLeavesNoTempFileBehind(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.checkpoint.json")
+
+	if err := saveCheckpoint(path, Checkpoint{SessionID: "sess-1"}); err != nil {
+		t.Fatalf("saveCheckpoint: %v", err)
+	}
+
+	tmp := path + ".tmp"
+	if hasCheckpoint(tmp) {
+		t.Fatalf("temp file %q still exists after saveCheckpoint's atomic rename", tmp)
+	}
+}
+
+func TestDeleteCheckpointRemovesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.checkpoint.json")
+	if err := saveCheckpoint(path, Checkpoint{SessionID: "sess-1"}); err != nil {
+		t.Fatalf("saveCheckpoint: %v", err)
+	}
+
+	if err := deleteCheckpoint(path); err != nil {
+		t.Fatalf("deleteCheckpoint: %v", err)
+	}
+	if hasCheckpoint(path) {
+		t.Fatal("hasCheckpoint reported true after deleteCheckpoint succeeded")
+	}
+}
+
+func TestDeleteCheckpointOnMissingFileIsNotAnError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "never-written.checkpoint.json")
+	if err := deleteCheckpoint(path); err != nil {
+		t.Fatalf("deleteCheckpoint on missing file: %v, want nil", err)
+	}
+}
+
+func TestDefaultCheckpointPathUsesLabel(t *testing.T) {
+	got := defaultCheckpointPath("linkedin-golang")
+	want := filepath.Join(".", "linkedin-golang.checkpoint.json")
+	if got != want {
+		t.Fatalf("defaultCheckpointPath = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,275 @@
+package resume
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/browserbase/stagehand-go"
+
+	"github.com/gaurav639/job-application-automation/go/deadline"
+	"github.com/gaurav639/job-application-automation/go/modelprovider"
+	"github.com/gaurav639/job-application-automation/go/store"
+)
+
+const sdkVersion = "3.0.7"
+
+// snapshotSchema asks Extract for just enough page state to resume into:
+// the URL to re-navigate to and a DOM fingerprint to detect drift.
+var snapshotSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"url": map[string]any{
+			"type":        "string",
+			"description": "The current page URL",
+		},
+		"domHash": map[string]any{
+			"type":        "string",
+			"description": "A short fingerprint of the current DOM, for detecting whether the page changed since the last step",
+		},
+	},
+	"required": []string{"url"},
+}
+
+// ResumableRun drives client.Sessions.Execute one step at a time, so that a
+// dead session or an API outage only loses the in-flight step rather than
+// the whole batch.
+type ResumableRun struct {
+	Client *stagehand.Client
+
+	// Label names this run for logging and for the default checkpoint path.
+	Label string
+	// CheckpointPath overrides the default "<label>.checkpoint.json" file.
+	CheckpointPath string
+
+	Instruction string
+	MaxSteps    int
+	ModelName   string
+	AgentConfig stagehand.SessionExecuteParamsAgentConfig
+
+	// Deadlines bounds how long each Execute/Extract call is allowed to run,
+	// the same way jobboard.Session does for the adapter-driven path. A nil
+	// Deadlines means calls use ctx as given.
+	Deadlines *deadline.StepDeadlines
+
+	// Store, Board and JobID are optional: when Store is set, every Execute
+	// step is recorded as a store.AuditRow against Board/JobID so a user can
+	// re-watch any historical agent run, the same way jobboard.Session does
+	// for Act calls on the adapter-driven path.
+	Store store.Repository
+	Board string
+	JobID string
+
+	// Cost accumulates Usage for every Execute step, if set. Unlike
+	// Observe/Act/Extract, Execute's response carries real token counts, so
+	// this Usage gets a real CostUSD rather than the zero jobboard.withModel
+	// has to record.
+	Cost *modelprovider.CostTracker
+}
+
+// withDeadline runs fn under r's configured deadline for step, if any;
+// otherwise it runs fn against ctx directly.
+func (r *ResumableRun) withDeadline(ctx context.Context, step deadline.Step, fn func(ctx context.Context) error) error {
+	if r.Deadlines == nil {
+		return fn(ctx)
+	}
+	return r.Deadlines.Call(ctx, step, fn)
+}
+
+// auditExecute records an Execute step against r.Store, if the caller
+// configured one. An audit failure's error is discarded and never fails the
+// underlying Stagehand call, so a broken store can't block a run.
+func (r *ResumableRun) auditExecute(ctx context.Context, sessionID, detail string) {
+	if r.Store == nil {
+		return
+	}
+	_ = r.Store.AppendAudit(ctx, store.AuditRow{
+		Board:      r.Board,
+		JobID:      r.JobID,
+		SessionID:  sessionID,
+		Step:       "execute",
+		Detail:     detail,
+		OccurredAt: time.Now(),
+	})
+}
+
+// recordCost logs an Execute step's real token usage against r.Cost, if the
+// caller configured one, priced at the provider encoded in r.ModelName.
+func (r *ResumableRun) recordCost(inputTokens, outputTokens int) {
+	if r.Cost == nil {
+		return
+	}
+	provider := modelprovider.ProviderPrefix(r.ModelName)
+	r.Cost.Record(modelprovider.Usage{
+		Provider:     provider,
+		Step:         modelprovider.StepExecute,
+		InputTokens:  inputTokens,
+		OutputTokens: outputTokens,
+		CostUSD:      modelprovider.PriceUSD(provider, inputTokens, outputTokens),
+	})
+}
+
+func (r *ResumableRun) checkpointPath() string {
+	if r.CheckpointPath != "" {
+		return r.CheckpointPath
+	}
+	return defaultCheckpointPath(r.Label)
+}
+
+// Run drives the agent to completion, resuming from a prior checkpoint if
+// one exists for this run's label, or starting a fresh session otherwise.
+// It returns the final checkpoint reached.
+func (r *ResumableRun) Run(ctx context.Context) (Checkpoint, error) {
+	path := r.checkpointPath()
+	if hasCheckpoint(path) {
+		cp, err := loadCheckpoint(path)
+		if err != nil {
+			return Checkpoint{}, err
+		}
+		return r.resume(ctx, cp)
+	}
+	return r.start(ctx)
+}
+
+// start begins a brand-new session and drives it step by step.
+func (r *ResumableRun) start(ctx context.Context) (Checkpoint, error) {
+	startResponse, err := r.Client.Sessions.Start(ctx, stagehand.SessionStartParams{
+		ModelName:   r.ModelName,
+		XLanguage:   stagehand.SessionStartParamsXLanguageTypescript,
+		XSDKVersion: stagehand.String(sdkVersion),
+	})
+	if err != nil {
+		return Checkpoint{}, fmt.Errorf("start session: %w", err)
+	}
+
+	cp := Checkpoint{SessionID: startResponse.Data.SessionID, LastInstruction: r.Instruction}
+	return r.drive(ctx, cp)
+}
+
+// resume starts a fresh session in place of the (possibly dead) one in cp,
+// re-navigates to the snapshotted URL, and re-primes the agent with the
+// prior transcript as context before continuing from cp.StepIndex.
+func (r *ResumableRun) resume(ctx context.Context, cp Checkpoint) (Checkpoint, error) {
+	startResponse, err := r.Client.Sessions.Start(ctx, stagehand.SessionStartParams{
+		ModelName:   r.ModelName,
+		XLanguage:   stagehand.SessionStartParamsXLanguageTypescript,
+		XSDKVersion: stagehand.String(sdkVersion),
+	})
+	if err != nil {
+		return Checkpoint{}, fmt.Errorf("start resume session: %w", err)
+	}
+	cp.SessionID = startResponse.Data.SessionID
+
+	if cp.URL != "" {
+		if _, err := r.Client.Sessions.Navigate(ctx, cp.SessionID, stagehand.SessionNavigateParams{
+			URL:         cp.URL,
+			FrameID:     stagehand.String(""),
+			XLanguage:   stagehand.SessionNavigateParamsXLanguageTypescript,
+			XSDKVersion: stagehand.String(sdkVersion),
+		}); err != nil {
+			return Checkpoint{}, fmt.Errorf("navigate to checkpointed url: %w", err)
+		}
+	}
+
+	cp.LastInstruction = primeWithTranscript(r.Instruction, cp.Transcript)
+	return r.drive(ctx, cp)
+}
+
+// primeWithTranscript prefixes instruction with a summary of what earlier
+// steps already did, so the agent doesn't repeat work after a resume.
+func primeWithTranscript(instruction string, transcript []string) string {
+	if len(transcript) == 0 {
+		return instruction
+	}
+	return fmt.Sprintf("You already completed these steps:\n- %s\nContinue the task: %s",
+		strings.Join(transcript, "\n- "), instruction)
+}
+
+// drive runs Execute one step at a time starting from cp, checkpointing
+// after every step, until MaxSteps is reached or the agent reports success.
+func (r *ResumableRun) drive(ctx context.Context, cp Checkpoint) (Checkpoint, error) {
+	for cp.StepIndex < r.MaxSteps {
+		var message string
+		var success bool
+		var inputTokens, outputTokens int
+		err := r.withDeadline(ctx, deadline.StepExecute, func(ctx context.Context) error {
+			resp, err := r.Client.Sessions.Execute(ctx, cp.SessionID, stagehand.SessionExecuteParams{
+				ExecuteOptions: stagehand.SessionExecuteParamsExecuteOptions{
+					Instruction: cp.LastInstruction,
+					MaxSteps:    stagehand.Float(1),
+				},
+				AgentConfig: r.AgentConfig,
+				XLanguage:   stagehand.SessionExecuteParamsXLanguageTypescript,
+				XSDKVersion: stagehand.String(sdkVersion),
+			})
+			if err != nil {
+				return err
+			}
+			message = resp.Data.Result.Message
+			success = resp.Data.Result.Success
+			inputTokens = int(resp.Data.Result.Usage.InputTokens)
+			outputTokens = int(resp.Data.Result.Usage.OutputTokens)
+			return nil
+		})
+		if err != nil {
+			_ = saveCheckpoint(r.checkpointPath(), cp)
+			return cp, fmt.Errorf("execute step %d: %w", cp.StepIndex, err)
+		}
+
+		cp.StepIndex++
+		cp.Transcript = append(cp.Transcript, message)
+		r.auditExecute(ctx, cp.SessionID, message)
+		r.recordCost(inputTokens, outputTokens)
+
+		snapshot, err := r.snapshot(ctx, cp.SessionID)
+		if err != nil {
+			_ = saveCheckpoint(r.checkpointPath(), cp)
+			return cp, fmt.Errorf("snapshot after step %d: %w", cp.StepIndex, err)
+		}
+		cp.URL = snapshot.URL
+		cp.DOMHash = snapshot.DOMHash
+
+		if success {
+			// The run finished: drop the checkpoint rather than saving it, so
+			// a later Run() for this label starts a fresh session instead of
+			// resuming a job it already finished applying to.
+			if err := deleteCheckpoint(r.checkpointPath()); err != nil {
+				return cp, err
+			}
+			return cp, nil
+		}
+
+		if err := saveCheckpoint(r.checkpointPath(), cp); err != nil {
+			return cp, err
+		}
+	}
+	return cp, nil
+}
+
+type pageSnapshot struct {
+	URL     string
+	DOMHash string
+}
+
+// snapshot extracts the current URL and a DOM fingerprint so the checkpoint
+// can re-navigate to the right place on resume.
+func (r *ResumableRun) snapshot(ctx context.Context, sessionID string) (pageSnapshot, error) {
+	var snap pageSnapshot
+	err := r.withDeadline(ctx, deadline.StepExtract, func(ctx context.Context) error {
+		resp, err := r.Client.Sessions.Extract(ctx, sessionID, stagehand.SessionExtractParams{
+			Instruction: stagehand.String("report the current page URL and a short fingerprint of its DOM"),
+			Schema:      snapshotSchema,
+			XLanguage:   stagehand.SessionExtractParamsXLanguageTypescript,
+			XSDKVersion: stagehand.String(sdkVersion),
+		})
+		if err != nil {
+			return err
+		}
+		fields, _ := resp.Data.Result.(map[string]any)
+		snap.URL, _ = fields["url"].(string)
+		snap.DOMHash, _ = fields["domHash"].(string)
+		return nil
+	})
+	return snap, err
+}
@@ -0,0 +1,125 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoStore backs Repository with MongoDB, for deployments that prefer a
+// document store over SQL, e.g. to keep Answers and ScreenshotURLs as a
+// native subdocument and array instead of encoded text columns.
+type MongoStore struct {
+	client      *mongo.Client
+	collections *mongo.Database
+}
+
+// mongoRecord mirrors Record with BSON tags and an explicit compound _id, so
+// Put can upsert by (board, job_id) the same way sqlite.go does by primary key.
+type mongoRecord struct {
+	ID             mongoRecordID     `bson:"_id"`
+	Board          string            `bson:"board"`
+	JobID          string            `bson:"job_id"`
+	Status         Status            `bson:"status"`
+	AppliedAt      time.Time         `bson:"applied_at,omitempty"`
+	SessionID      string            `bson:"session_id"`
+	ExtractedJD    string            `bson:"extracted_jd"`
+	Answers        map[string]string `bson:"answers,omitempty"`
+	ScreenshotURLs []string          `bson:"screenshot_urls,omitempty"`
+}
+
+type mongoRecordID struct {
+	Board string `bson:"board"`
+	JobID string `bson:"job_id"`
+}
+
+type mongoAuditRow struct {
+	Board      string    `bson:"board"`
+	JobID      string    `bson:"job_id"`
+	SessionID  string    `bson:"session_id"`
+	Step       string    `bson:"step"`
+	Detail     string    `bson:"detail"`
+	OccurredAt time.Time `bson:"occurred_at"`
+}
+
+// NewMongoStore connects to uri and selects database, creating the
+// applications/audit_log collections on first write (MongoDB needs no
+// up-front schema or migration).
+func NewMongoStore(uri, database string) (*MongoStore, error) {
+	client, err := mongo.Connect(context.Background(), options.Client().ApplyURI(uri))
+	if err != nil {
+		return nil, fmt.Errorf("connect to mongo store: %w", err)
+	}
+	if err := client.Ping(context.Background(), nil); err != nil {
+		_ = client.Disconnect(context.Background())
+		return nil, fmt.Errorf("ping mongo store: %w", err)
+	}
+	return &MongoStore{client: client, collections: client.Database(database)}, nil
+}
+
+func (s *MongoStore) applications() *mongo.Collection { return s.collections.Collection("applications") }
+func (s *MongoStore) auditLog() *mongo.Collection      { return s.collections.Collection("audit_log") }
+
+func (s *MongoStore) Get(ctx context.Context, board, jobID string) (*Record, error) {
+	var doc mongoRecord
+	err := s.applications().FindOne(ctx, bson.M{"_id": mongoRecordID{Board: board, JobID: jobID}}).Decode(&doc)
+	if err == mongo.ErrNoDocuments {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get record: %w", err)
+	}
+	return &Record{
+		Board:          doc.Board,
+		JobID:          doc.JobID,
+		Status:         doc.Status,
+		AppliedAt:      doc.AppliedAt,
+		SessionID:      doc.SessionID,
+		ExtractedJD:    doc.ExtractedJD,
+		Answers:        doc.Answers,
+		ScreenshotURLs: doc.ScreenshotURLs,
+	}, nil
+}
+
+func (s *MongoStore) Put(ctx context.Context, r *Record) error {
+	id := mongoRecordID{Board: r.Board, JobID: r.JobID}
+	doc := mongoRecord{
+		ID:             id,
+		Board:          r.Board,
+		JobID:          r.JobID,
+		Status:         r.Status,
+		AppliedAt:      r.AppliedAt,
+		SessionID:      r.SessionID,
+		ExtractedJD:    r.ExtractedJD,
+		Answers:        r.Answers,
+		ScreenshotURLs: r.ScreenshotURLs,
+	}
+	_, err := s.applications().ReplaceOne(ctx, bson.M{"_id": id}, doc, options.Replace().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("put record: %w", err)
+	}
+	return nil
+}
+
+func (s *MongoStore) AppendAudit(ctx context.Context, row AuditRow) error {
+	_, err := s.auditLog().InsertOne(ctx, mongoAuditRow{
+		Board:      row.Board,
+		JobID:      row.JobID,
+		SessionID:  row.SessionID,
+		Step:       row.Step,
+		Detail:     row.Detail,
+		OccurredAt: row.OccurredAt,
+	})
+	if err != nil {
+		return fmt.Errorf("append audit row: %w", err)
+	}
+	return nil
+}
+
+func (s *MongoStore) Close() error {
+	return s.client.Disconnect(context.Background())
+}
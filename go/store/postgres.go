@@ -0,0 +1,128 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+const postgresSchema = `
+CREATE TABLE IF NOT EXISTS applications (
+	board           TEXT NOT NULL,
+	job_id          TEXT NOT NULL,
+	status          TEXT NOT NULL,
+	applied_at      TIMESTAMPTZ,
+	session_id      TEXT,
+	extracted_jd    TEXT,
+	answers         TEXT,
+	screenshot_urls TEXT,
+	PRIMARY KEY (board, job_id)
+);
+
+CREATE TABLE IF NOT EXISTS audit_log (
+	board       TEXT NOT NULL,
+	job_id      TEXT NOT NULL,
+	session_id  TEXT NOT NULL,
+	step        TEXT NOT NULL,
+	detail      TEXT,
+	occurred_at TIMESTAMPTZ NOT NULL
+);
+`
+
+// PostgresStore backs Repository with Postgres, for multi-worker deployments
+// that need a store shared across processes instead of SQLite's single file.
+type PostgresStore struct {
+	db *sql.DB
+}
+
+// NewPostgresStore opens dsn (a standard Postgres connection string) and
+// ensures its schema exists.
+func NewPostgresStore(dsn string) (*PostgresStore, error) {
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("open postgres store: %w", err)
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("connect to postgres store: %w", err)
+	}
+	if _, err := db.Exec(postgresSchema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("migrate postgres store: %w", err)
+	}
+	return &PostgresStore{db: db}, nil
+}
+
+func (s *PostgresStore) Get(ctx context.Context, board, jobID string) (*Record, error) {
+	row := s.db.QueryRowContext(ctx, `
+		SELECT board, job_id, status, applied_at, session_id, extracted_jd, answers, screenshot_urls
+		FROM applications WHERE board = $1 AND job_id = $2`, board, jobID)
+
+	var r Record
+	var appliedAt sql.NullTime
+	var answers, screenshots sql.NullString
+	if err := row.Scan(&r.Board, &r.JobID, &r.Status, &appliedAt, &r.SessionID, &r.ExtractedJD, &answers, &screenshots); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get record: %w", err)
+	}
+	if appliedAt.Valid {
+		r.AppliedAt = appliedAt.Time
+	}
+	if answers.Valid && answers.String != "" {
+		if err := json.Unmarshal([]byte(answers.String), &r.Answers); err != nil {
+			return nil, fmt.Errorf("parse answers: %w", err)
+		}
+	}
+	if screenshots.Valid && screenshots.String != "" {
+		r.ScreenshotURLs = strings.Split(screenshots.String, "\n")
+	}
+	return &r, nil
+}
+
+func (s *PostgresStore) Put(ctx context.Context, r *Record) error {
+	answers, err := json.Marshal(r.Answers)
+	if err != nil {
+		return fmt.Errorf("marshal answers: %w", err)
+	}
+	var appliedAt sql.NullTime
+	if !r.AppliedAt.IsZero() {
+		appliedAt = sql.NullTime{Time: r.AppliedAt, Valid: true}
+	}
+
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO applications (board, job_id, status, applied_at, session_id, extracted_jd, answers, screenshot_urls)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (board, job_id) DO UPDATE SET
+			status = excluded.status,
+			applied_at = excluded.applied_at,
+			session_id = excluded.session_id,
+			extracted_jd = excluded.extracted_jd,
+			answers = excluded.answers,
+			screenshot_urls = excluded.screenshot_urls`,
+		r.Board, r.JobID, r.Status, appliedAt, r.SessionID, r.ExtractedJD, string(answers), strings.Join(r.ScreenshotURLs, "\n"))
+	if err != nil {
+		return fmt.Errorf("put record: %w", err)
+	}
+	return nil
+}
+
+func (s *PostgresStore) AppendAudit(ctx context.Context, row AuditRow) error {
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO audit_log (board, job_id, session_id, step, detail, occurred_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		row.Board, row.JobID, row.SessionID, row.Step, row.Detail, row.OccurredAt)
+	if err != nil {
+		return fmt.Errorf("append audit row: %w", err)
+	}
+	return nil
+}
+
+func (s *PostgresStore) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,128 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+const schema = `
+CREATE TABLE IF NOT EXISTS applications (
+	board           TEXT NOT NULL,
+	job_id          TEXT NOT NULL,
+	status          TEXT NOT NULL,
+	applied_at      TEXT,
+	session_id      TEXT,
+	extracted_jd    TEXT,
+	answers         TEXT,
+	screenshot_urls TEXT,
+	PRIMARY KEY (board, job_id)
+);
+
+CREATE TABLE IF NOT EXISTS audit_log (
+	board       TEXT NOT NULL,
+	job_id      TEXT NOT NULL,
+	session_id  TEXT NOT NULL,
+	step        TEXT NOT NULL,
+	detail      TEXT,
+	occurred_at TEXT NOT NULL
+);
+`
+
+// SQLiteStore is the default Repository: a single file, zero setup, good
+// enough for one worker applying to jobs sequentially.
+type SQLiteStore struct {
+	db *sql.DB
+}
+
+// NewSQLiteStore opens (creating if needed) a SQLite database at path and
+// ensures its schema exists.
+func NewSQLiteStore(path string) (*SQLiteStore, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, fmt.Errorf("open sqlite store: %w", err)
+	}
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("migrate sqlite store: %w", err)
+	}
+	return &SQLiteStore{db: db}, nil
+}
+
+func (s *SQLiteStore) Get(ctx context.Context, board, jobID string) (*Record, error) {
+	row := s.db.QueryRowContext(ctx, `
+		SELECT board, job_id, status, applied_at, session_id, extracted_jd, answers, screenshot_urls
+		FROM applications WHERE board = ? AND job_id = ?`, board, jobID)
+
+	var r Record
+	var appliedAt, answers, screenshots sql.NullString
+	if err := row.Scan(&r.Board, &r.JobID, &r.Status, &appliedAt, &r.SessionID, &r.ExtractedJD, &answers, &screenshots); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get record: %w", err)
+	}
+	if appliedAt.Valid && appliedAt.String != "" {
+		t, err := time.Parse(time.RFC3339, appliedAt.String)
+		if err != nil {
+			return nil, fmt.Errorf("parse applied_at: %w", err)
+		}
+		r.AppliedAt = t
+	}
+	if answers.Valid && answers.String != "" {
+		if err := json.Unmarshal([]byte(answers.String), &r.Answers); err != nil {
+			return nil, fmt.Errorf("parse answers: %w", err)
+		}
+	}
+	if screenshots.Valid && screenshots.String != "" {
+		r.ScreenshotURLs = strings.Split(screenshots.String, "\n")
+	}
+	return &r, nil
+}
+
+func (s *SQLiteStore) Put(ctx context.Context, r *Record) error {
+	answers, err := json.Marshal(r.Answers)
+	if err != nil {
+		return fmt.Errorf("marshal answers: %w", err)
+	}
+	var appliedAt string
+	if !r.AppliedAt.IsZero() {
+		appliedAt = r.AppliedAt.Format(time.RFC3339)
+	}
+
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO applications (board, job_id, status, applied_at, session_id, extracted_jd, answers, screenshot_urls)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (board, job_id) DO UPDATE SET
+			status = excluded.status,
+			applied_at = excluded.applied_at,
+			session_id = excluded.session_id,
+			extracted_jd = excluded.extracted_jd,
+			answers = excluded.answers,
+			screenshot_urls = excluded.screenshot_urls`,
+		r.Board, r.JobID, r.Status, appliedAt, r.SessionID, r.ExtractedJD, string(answers), strings.Join(r.ScreenshotURLs, "\n"))
+	if err != nil {
+		return fmt.Errorf("put record: %w", err)
+	}
+	return nil
+}
+
+func (s *SQLiteStore) AppendAudit(ctx context.Context, row AuditRow) error {
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO audit_log (board, job_id, session_id, step, detail, occurred_at)
+		VALUES (?, ?, ?, ?, ?, ?)`,
+		row.Board, row.JobID, row.SessionID, row.Step, row.Detail, row.OccurredAt.Format(time.RFC3339))
+	if err != nil {
+		return fmt.Errorf("append audit row: %w", err)
+	}
+	return nil
+}
+
+func (s *SQLiteStore) Close() error {
+	return s.db.Close()
+}
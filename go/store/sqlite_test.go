@@ -0,0 +1,100 @@
+package store
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSQLiteStoreGetMissingReturnsErrNotFound(t *testing.T) {
+	s := newTestSQLiteStore(t)
+
+	_, err := s.Get(context.Background(), "linkedin", "job-1")
+	if err != ErrNotFound {
+		t.Fatalf("Get = %v, want ErrNotFound", err)
+	}
+}
+
+func TestSQLiteStorePutGetRoundTrip(t *testing.T) {
+	s := newTestSQLiteStore(t)
+	ctx := context.Background()
+
+	want := &Record{
+		Board:          "linkedin",
+		JobID:          "job-1",
+		Status:         StatusApplied,
+		AppliedAt:      time.Now().Truncate(time.Second),
+		SessionID:      "sess-1",
+		ExtractedJD:    "full job description",
+		Answers:        map[string]string{"why this role?": "because"},
+		ScreenshotURLs: []string{"https://example.com/a.png", "https://example.com/b.png"},
+	}
+	if err := s.Put(ctx, want); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	got, err := s.Get(ctx, want.Board, want.JobID)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.Status != want.Status || got.SessionID != want.SessionID || got.ExtractedJD != want.ExtractedJD {
+		t.Fatalf("Get = %+v, want %+v", got, want)
+	}
+	if !got.AppliedAt.Equal(want.AppliedAt) {
+		t.Fatalf("AppliedAt = %v, want %v", got.AppliedAt, want.AppliedAt)
+	}
+	if got.Answers["why this role?"] != "because" {
+		t.Fatalf("Answers = %v, want %v", got.Answers, want.Answers)
+	}
+	if len(got.ScreenshotURLs) != 2 {
+		t.Fatalf("ScreenshotURLs = %v, want 2 entries", got.ScreenshotURLs)
+	}
+}
+
+func TestSQLiteStorePutUpsertsExistingRecord(t *testing.T) {
+	s := newTestSQLiteStore(t)
+	ctx := context.Background()
+
+	if err := s.Put(ctx, &Record{Board: "indeed", JobID: "job-2", Status: StatusSeen}); err != nil {
+		t.Fatalf("Put (seen): %v", err)
+	}
+	if err := s.Put(ctx, &Record{Board: "indeed", JobID: "job-2", Status: StatusApplied, SessionID: "sess-2"}); err != nil {
+		t.Fatalf("Put (applied): %v", err)
+	}
+
+	got, err := s.Get(ctx, "indeed", "job-2")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.Status != StatusApplied || got.SessionID != "sess-2" {
+		t.Fatalf("Get after upsert = %+v, want Status=applied SessionID=sess-2", got)
+	}
+}
+
+func TestSQLiteStoreAppendAudit(t *testing.T) {
+	s := newTestSQLiteStore(t)
+	ctx := context.Background()
+
+	row := AuditRow{
+		Board:      "linkedin",
+		JobID:      "job-1",
+		SessionID:  "sess-1",
+		Step:       "act",
+		Detail:     "clicked apply",
+		OccurredAt: time.Now(),
+	}
+	if err := s.AppendAudit(ctx, row); err != nil {
+		t.Fatalf("AppendAudit: %v", err)
+	}
+}
+
+func newTestSQLiteStore(t *testing.T) *SQLiteStore {
+	t.Helper()
+	s, err := NewSQLiteStore(filepath.Join(t.TempDir(), "jobapply.db"))
+	if err != nil {
+		t.Fatalf("NewSQLiteStore: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
@@ -0,0 +1,67 @@
+// Package store persists per-job application state across runs so a
+// long-running automation can dedupe work it has already done and leave an
+// audit trail of every action it took against a real job posting.
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrNotFound is returned by Repository.Get when no record exists for the
+// given board and job ID.
+var ErrNotFound = errors.New("store: record not found")
+
+// Status is where a single job stands in the application pipeline.
+type Status string
+
+const (
+	StatusSeen     Status = "seen"
+	StatusQueued   Status = "queued"
+	StatusApplied  Status = "applied"
+	StatusRejected Status = "rejected"
+	StatusOffered  Status = "offered"
+)
+
+// Record is the persisted state for one (board, job ID) pair.
+type Record struct {
+	Board          string
+	JobID          string
+	Status         Status
+	AppliedAt      time.Time
+	SessionID      string
+	ExtractedJD    string
+	Answers        map[string]string // screening question -> answer given
+	ScreenshotURLs []string
+}
+
+// AuditRow is one line of the audit trail: a single Stagehand call made
+// against a job, linked back to the Browserbase session it ran in so a user
+// can re-watch exactly what happened.
+type AuditRow struct {
+	Board      string
+	JobID      string
+	SessionID  string
+	Step       string // e.g. "act", "execute"
+	Detail     string
+	OccurredAt time.Time
+}
+
+// Repository is the persistence boundary the main loop consults before
+// queuing an Apply and writes to after every action. SQLite is the default
+// implementation; Postgres and MongoDB are pluggable alternatives for
+// multi-worker deployments.
+type Repository interface {
+	// Get returns the record for (board, jobID), or ErrNotFound.
+	Get(ctx context.Context, board, jobID string) (*Record, error)
+
+	// Put inserts or updates the record for (board, jobID).
+	Put(ctx context.Context, record *Record) error
+
+	// AppendAudit records one action taken against a job.
+	AppendAudit(ctx context.Context, row AuditRow) error
+
+	// Close releases any resources held by the repository.
+	Close() error
+}